@@ -0,0 +1,5962 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: catalog.proto
+
+package catalog
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Game struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Developer     string                 `protobuf:"bytes,4,opt,name=developer,proto3" json:"developer,omitempty"`
+	Publisher     string                 `protobuf:"bytes,5,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	ReleaseDate   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	Genres        []*Genre               `protobuf:"bytes,7,rep,name=genres,proto3" json:"genres,omitempty"`
+	Platforms     []*Platform            `protobuf:"bytes,8,rep,name=platforms,proto3" json:"platforms,omitempty"`
+	Price         float64                `protobuf:"fixed64,9,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,10,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	AverageRating float64                `protobuf:"fixed64,11,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Currency      string                 `protobuf:"bytes,14,opt,name=currency,proto3" json:"currency,omitempty"`
+	Prices        []*Price               `protobuf:"bytes,15,rep,name=prices,proto3" json:"prices,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// Only set when the game was returned by a full-text search (q).
+	Relevance            float64    `protobuf:"fixed64,17,opt,name=relevance,proto3" json:"relevance,omitempty"`
+	TitleHighlight       string     `protobuf:"bytes,18,opt,name=title_highlight,json=titleHighlight,proto3" json:"title_highlight,omitempty"`
+	DescriptionHighlight string     `protobuf:"bytes,19,opt,name=description_highlight,json=descriptionHighlight,proto3" json:"description_highlight,omitempty"`
+	Developers           []*Company `protobuf:"bytes,20,rep,name=developers,proto3" json:"developers,omitempty"`
+	Publishers           []*Company `protobuf:"bytes,21,rep,name=publishers,proto3" json:"publishers,omitempty"`
+	FranchiseId          *uint32    `protobuf:"varint,22,opt,name=franchise_id,json=franchiseId,proto3,oneof" json:"franchise_id,omitempty"`
+	Franchise            *Franchise `protobuf:"bytes,23,opt,name=franchise,proto3" json:"franchise,omitempty"`
+	// Only set when requested with include "related".
+	Related    *RelatedGames `protobuf:"bytes,24,opt,name=related,proto3" json:"related,omitempty"`
+	Releases   []*Release    `protobuf:"bytes,25,rep,name=releases,proto3" json:"releases,omitempty"`
+	AgeRatings []*AgeRating  `protobuf:"bytes,26,rep,name=age_ratings,json=ageRatings,proto3" json:"age_ratings,omitempty"`
+	// Locale of title and description, set when languages were requested.
+	Locale string `protobuf:"bytes,27,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Only set when requested with include "translations".
+	Translations []*GameTranslation `protobuf:"bytes,28,rep,name=translations,proto3" json:"translations,omitempty"`
+	// Only set when requested with include "media".
+	Media              []*GameMedia          `protobuf:"bytes,29,rep,name=media,proto3" json:"media,omitempty"`
+	SystemRequirements []*SystemRequirements `protobuf:"bytes,30,rep,name=system_requirements,json=systemRequirements,proto3" json:"system_requirements,omitempty"`
+	// Approved tags only, most voted first.
+	Tags      []*GameTag      `protobuf:"bytes,31,rep,name=tags,proto3" json:"tags,omitempty"`
+	Languages []*GameLanguage `protobuf:"bytes,32,rep,name=languages,proto3" json:"languages,omitempty"`
+	// single_player, local_multiplayer, online_multiplayer, local_coop,
+	// online_coop, mmo.
+	GameModes []string `protobuf:"bytes,33,rep,name=game_modes,json=gameModes,proto3" json:"game_modes,omitempty"`
+	// 0 when unknown.
+	MinPlayers int32 `protobuf:"varint,34,opt,name=min_players,json=minPlayers,proto3" json:"min_players,omitempty"`
+	MaxPlayers int32 `protobuf:"varint,35,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	// colorblind_mode, closed_captions, remappable_controls, difficulty_options,
+	// text_scaling, screen_reader, high_contrast, reduced_motion.
+	Accessibility []string      `protobuf:"bytes,36,rep,name=accessibility,proto3" json:"accessibility,omitempty"`
+	ExternalIds   []*ExternalID `protobuf:"bytes,37,rep,name=external_ids,json=externalIds,proto3" json:"external_ids,omitempty"`
+	// Immutable public identifier.
+	Uuid string `protobuf:"bytes,38,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Derived from the title; former slugs still resolve through GetGame.ref.
+	Slug          string `protobuf:"bytes,39,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Game) Reset() {
+	*x = Game{}
+	mi := &file_catalog_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Game) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Game) ProtoMessage() {}
+
+func (x *Game) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Game.ProtoReflect.Descriptor instead.
+func (*Game) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Game) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Game) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Game) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Game) GetDeveloper() string {
+	if x != nil {
+		return x.Developer
+	}
+	return ""
+}
+
+func (x *Game) GetPublisher() string {
+	if x != nil {
+		return x.Publisher
+	}
+	return ""
+}
+
+func (x *Game) GetReleaseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+func (x *Game) GetGenres() []*Genre {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *Game) GetPlatforms() []*Platform {
+	if x != nil {
+		return x.Platforms
+	}
+	return nil
+}
+
+func (x *Game) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Game) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Game) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *Game) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Game) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Game) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Game) GetPrices() []*Price {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *Game) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Game) GetRelevance() float64 {
+	if x != nil {
+		return x.Relevance
+	}
+	return 0
+}
+
+func (x *Game) GetTitleHighlight() string {
+	if x != nil {
+		return x.TitleHighlight
+	}
+	return ""
+}
+
+func (x *Game) GetDescriptionHighlight() string {
+	if x != nil {
+		return x.DescriptionHighlight
+	}
+	return ""
+}
+
+func (x *Game) GetDevelopers() []*Company {
+	if x != nil {
+		return x.Developers
+	}
+	return nil
+}
+
+func (x *Game) GetPublishers() []*Company {
+	if x != nil {
+		return x.Publishers
+	}
+	return nil
+}
+
+func (x *Game) GetFranchiseId() uint32 {
+	if x != nil && x.FranchiseId != nil {
+		return *x.FranchiseId
+	}
+	return 0
+}
+
+func (x *Game) GetFranchise() *Franchise {
+	if x != nil {
+		return x.Franchise
+	}
+	return nil
+}
+
+func (x *Game) GetRelated() *RelatedGames {
+	if x != nil {
+		return x.Related
+	}
+	return nil
+}
+
+func (x *Game) GetReleases() []*Release {
+	if x != nil {
+		return x.Releases
+	}
+	return nil
+}
+
+func (x *Game) GetAgeRatings() []*AgeRating {
+	if x != nil {
+		return x.AgeRatings
+	}
+	return nil
+}
+
+func (x *Game) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Game) GetTranslations() []*GameTranslation {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
+func (x *Game) GetMedia() []*GameMedia {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *Game) GetSystemRequirements() []*SystemRequirements {
+	if x != nil {
+		return x.SystemRequirements
+	}
+	return nil
+}
+
+func (x *Game) GetTags() []*GameTag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Game) GetLanguages() []*GameLanguage {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *Game) GetGameModes() []string {
+	if x != nil {
+		return x.GameModes
+	}
+	return nil
+}
+
+func (x *Game) GetMinPlayers() int32 {
+	if x != nil {
+		return x.MinPlayers
+	}
+	return 0
+}
+
+func (x *Game) GetMaxPlayers() int32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+func (x *Game) GetAccessibility() []string {
+	if x != nil {
+		return x.Accessibility
+	}
+	return nil
+}
+
+func (x *Game) GetExternalIds() []*ExternalID {
+	if x != nil {
+		return x.ExternalIds
+	}
+	return nil
+}
+
+func (x *Game) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Game) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+// Price overrides the base price of a game for a platform and/or a region.
+// The amount is an exact decimal encoded as a string (e.g. "59.99").
+type Price struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlatformId    uint32                 `protobuf:"varint,1,opt,name=platform_id,json=platformId,proto3" json:"platform_id,omitempty"`
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Price) Reset() {
+	*x = Price{}
+	mi := &file_catalog_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Price) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Price) ProtoMessage() {}
+
+func (x *Price) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Price.ProtoReflect.Descriptor instead.
+func (*Price) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Price) GetPlatformId() uint32 {
+	if x != nil {
+		return x.PlatformId
+	}
+	return 0
+}
+
+func (x *Price) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Price) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Price) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// Release is the launch of a game on a platform and/or in a region; an empty
+// platform_id or region applies to all of them. The date is the first day of
+// the period given by precision (day, month, quarter, year).
+type Release struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	PlatformId uint32                 `protobuf:"varint,1,opt,name=platform_id,json=platformId,proto3" json:"platform_id,omitempty"`
+	Region     string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Date       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Precision  string                 `protobuf:"bytes,4,opt,name=precision,proto3" json:"precision,omitempty"`
+	// announced, delayed, released or cancelled.
+	Status        string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Release) Reset() {
+	*x = Release{}
+	mi := &file_catalog_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Release) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Release) ProtoMessage() {}
+
+func (x *Release) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Release.ProtoReflect.Descriptor instead.
+func (*Release) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Release) GetPlatformId() uint32 {
+	if x != nil {
+		return x.PlatformId
+	}
+	return 0
+}
+
+func (x *Release) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Release) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Release) GetPrecision() string {
+	if x != nil {
+		return x.Precision
+	}
+	return ""
+}
+
+func (x *Release) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// AgeRating is the classification of a game by a rating board (PEGI, ESRB,
+// USK, CERO). minimum_age is derived from the rating and ignored on writes.
+type AgeRating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Board         string                 `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Rating        string                 `protobuf:"bytes,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	MinimumAge    int32                  `protobuf:"varint,3,opt,name=minimum_age,json=minimumAge,proto3" json:"minimum_age,omitempty"`
+	Descriptors   []string               `protobuf:"bytes,4,rep,name=descriptors,proto3" json:"descriptors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgeRating) Reset() {
+	*x = AgeRating{}
+	mi := &file_catalog_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgeRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgeRating) ProtoMessage() {}
+
+func (x *AgeRating) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgeRating.ProtoReflect.Descriptor instead.
+func (*AgeRating) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AgeRating) GetBoard() string {
+	if x != nil {
+		return x.Board
+	}
+	return ""
+}
+
+func (x *AgeRating) GetRating() string {
+	if x != nil {
+		return x.Rating
+	}
+	return ""
+}
+
+func (x *AgeRating) GetMinimumAge() int32 {
+	if x != nil {
+		return x.MinimumAge
+	}
+	return 0
+}
+
+func (x *AgeRating) GetDescriptors() []string {
+	if x != nil {
+		return x.Descriptors
+	}
+	return nil
+}
+
+// GameTranslation holds the title and description of a game in a locale
+// (BCP 47). An empty field is not translated and falls back along the chain.
+type GameTranslation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        uint32                 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameTranslation) Reset() {
+	*x = GameTranslation{}
+	mi := &file_catalog_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameTranslation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameTranslation) ProtoMessage() {}
+
+func (x *GameTranslation) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameTranslation.ProtoReflect.Descriptor instead.
+func (*GameTranslation) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GameTranslation) GetGameId() uint32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *GameTranslation) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *GameTranslation) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GameTranslation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GameTranslation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GameTranslation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// GameMedia is an asset of a game's gallery (cover, screenshot, trailer,
+// logo), ordered by position. At most one asset per type is primary.
+type GameMedia struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GameId        uint32                 `protobuf:"varint,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Caption       string                 `protobuf:"bytes,5,opt,name=caption,proto3" json:"caption,omitempty"`
+	Width         int32                  `protobuf:"varint,6,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	Position      int32                  `protobuf:"varint,8,opt,name=position,proto3" json:"position,omitempty"`
+	Primary       bool                   `protobuf:"varint,9,opt,name=primary,proto3" json:"primary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameMedia) Reset() {
+	*x = GameMedia{}
+	mi := &file_catalog_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameMedia) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameMedia) ProtoMessage() {}
+
+func (x *GameMedia) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameMedia.ProtoReflect.Descriptor instead.
+func (*GameMedia) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GameMedia) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GameMedia) GetGameId() uint32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *GameMedia) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GameMedia) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GameMedia) GetCaption() string {
+	if x != nil {
+		return x.Caption
+	}
+	return ""
+}
+
+func (x *GameMedia) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *GameMedia) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GameMedia) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *GameMedia) GetPrimary() bool {
+	if x != nil {
+		return x.Primary
+	}
+	return false
+}
+
+// SystemRequirements is the minimum or recommended configuration of a game
+// on a platform. Quantities are in megabytes; 0 means unknown.
+type SystemRequirements struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	PlatformId uint32                 `protobuf:"varint,1,opt,name=platform_id,json=platformId,proto3" json:"platform_id,omitempty"`
+	// minimum (default) or recommended.
+	Tier           string `protobuf:"bytes,2,opt,name=tier,proto3" json:"tier,omitempty"`
+	Os             string `protobuf:"bytes,3,opt,name=os,proto3" json:"os,omitempty"`
+	Cpu            string `protobuf:"bytes,4,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Gpu            string `protobuf:"bytes,5,opt,name=gpu,proto3" json:"gpu,omitempty"`
+	MemoryMb       int32  `protobuf:"varint,6,opt,name=memory_mb,json=memoryMb,proto3" json:"memory_mb,omitempty"`
+	StorageMb      int32  `protobuf:"varint,7,opt,name=storage_mb,json=storageMb,proto3" json:"storage_mb,omitempty"`
+	DirectxVersion string `protobuf:"bytes,8,opt,name=directx_version,json=directxVersion,proto3" json:"directx_version,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SystemRequirements) Reset() {
+	*x = SystemRequirements{}
+	mi := &file_catalog_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemRequirements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRequirements) ProtoMessage() {}
+
+func (x *SystemRequirements) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRequirements.ProtoReflect.Descriptor instead.
+func (*SystemRequirements) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SystemRequirements) GetPlatformId() uint32 {
+	if x != nil {
+		return x.PlatformId
+	}
+	return 0
+}
+
+func (x *SystemRequirements) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *SystemRequirements) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *SystemRequirements) GetCpu() string {
+	if x != nil {
+		return x.Cpu
+	}
+	return ""
+}
+
+func (x *SystemRequirements) GetGpu() string {
+	if x != nil {
+		return x.Gpu
+	}
+	return ""
+}
+
+func (x *SystemRequirements) GetMemoryMb() int32 {
+	if x != nil {
+		return x.MemoryMb
+	}
+	return 0
+}
+
+func (x *SystemRequirements) GetStorageMb() int32 {
+	if x != nil {
+		return x.StorageMb
+	}
+	return 0
+}
+
+func (x *SystemRequirements) GetDirectxVersion() string {
+	if x != nil {
+		return x.DirectxVersion
+	}
+	return ""
+}
+
+// Tag is a player-suggested keyword, moderated before it shows on games.
+// game_count and votes are only set by GetTags.
+type Tag struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// pending, approved or rejected.
+	Status        string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	GameCount     int64  `protobuf:"varint,4,opt,name=game_count,json=gameCount,proto3" json:"game_count,omitempty"`
+	Votes         int64  `protobuf:"varint,5,opt,name=votes,proto3" json:"votes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_catalog_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Tag) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Tag) GetGameCount() int64 {
+	if x != nil {
+		return x.GameCount
+	}
+	return 0
+}
+
+func (x *Tag) GetVotes() int64 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+type GameTag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagId         uint32                 `protobuf:"varint,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	Votes         int32                  `protobuf:"varint,2,opt,name=votes,proto3" json:"votes,omitempty"`
+	Tag           *Tag                   `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameTag) Reset() {
+	*x = GameTag{}
+	mi := &file_catalog_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameTag) ProtoMessage() {}
+
+func (x *GameTag) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameTag.ProtoReflect.Descriptor instead.
+func (*GameTag) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GameTag) GetTagId() uint32 {
+	if x != nil {
+		return x.TagId
+	}
+	return 0
+}
+
+func (x *GameTag) GetVotes() int32 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *GameTag) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+// GameLanguage tells how a language (BCP 47) is supported by a game.
+type GameLanguage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Language      string                 `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	Interface     bool                   `protobuf:"varint,2,opt,name=interface,proto3" json:"interface,omitempty"`
+	Audio         bool                   `protobuf:"varint,3,opt,name=audio,proto3" json:"audio,omitempty"`
+	Subtitles     bool                   `protobuf:"varint,4,opt,name=subtitles,proto3" json:"subtitles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameLanguage) Reset() {
+	*x = GameLanguage{}
+	mi := &file_catalog_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameLanguage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameLanguage) ProtoMessage() {}
+
+func (x *GameLanguage) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameLanguage.ProtoReflect.Descriptor instead.
+func (*GameLanguage) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GameLanguage) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *GameLanguage) GetInterface() bool {
+	if x != nil {
+		return x.Interface
+	}
+	return false
+}
+
+func (x *GameLanguage) GetAudio() bool {
+	if x != nil {
+		return x.Audio
+	}
+	return false
+}
+
+func (x *GameLanguage) GetSubtitles() bool {
+	if x != nil {
+		return x.Subtitles
+	}
+	return false
+}
+
+// ExternalID is the identifier of a game for a third-party provider: steam,
+// igdb, psn, eshop, xbox, gog or epic.
+type ExternalID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExternalID) Reset() {
+	*x = ExternalID{}
+	mi := &file_catalog_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExternalID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalID) ProtoMessage() {}
+
+func (x *ExternalID) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalID.ProtoReflect.Descriptor instead.
+func (*ExternalID) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ExternalID) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ExternalID) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+type Genre struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Genre) Reset() {
+	*x = Genre{}
+	mi := &file_catalog_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Genre) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Genre) ProtoMessage() {}
+
+func (x *Genre) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Genre.ProtoReflect.Descriptor instead.
+func (*Genre) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Genre) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Genre) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Platform struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Platform) Reset() {
+	*x = Platform{}
+	mi := &file_catalog_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Platform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Platform) ProtoMessage() {}
+
+func (x *Platform) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Platform.ProtoReflect.Descriptor instead.
+func (*Platform) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Platform) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Platform) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Franchise struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Franchise) Reset() {
+	*x = Franchise{}
+	mi := &file_catalog_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Franchise) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Franchise) ProtoMessage() {}
+
+func (x *Franchise) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Franchise.ProtoReflect.Descriptor instead.
+func (*Franchise) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Franchise) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Franchise) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Franchise) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// A typed edge between two games: related_game is a <type> of game
+// (dlc, expansion, remaster, remake, port, bundle, sequel).
+type GameRelation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GameId        uint32                 `protobuf:"varint,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	RelatedGameId uint32                 `protobuf:"varint,3,opt,name=related_game_id,json=relatedGameId,proto3" json:"related_game_id,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Game          *Game                  `protobuf:"bytes,5,opt,name=game,proto3" json:"game,omitempty"`
+	RelatedGame   *Game                  `protobuf:"bytes,6,opt,name=related_game,json=relatedGame,proto3" json:"related_game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameRelation) Reset() {
+	*x = GameRelation{}
+	mi := &file_catalog_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameRelation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameRelation) ProtoMessage() {}
+
+func (x *GameRelation) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameRelation.ProtoReflect.Descriptor instead.
+func (*GameRelation) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GameRelation) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GameRelation) GetGameId() uint32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *GameRelation) GetRelatedGameId() uint32 {
+	if x != nil {
+		return x.RelatedGameId
+	}
+	return 0
+}
+
+func (x *GameRelation) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GameRelation) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *GameRelation) GetRelatedGame() *Game {
+	if x != nil {
+		return x.RelatedGame
+	}
+	return nil
+}
+
+type RelatedGames struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Series           []*Game                `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
+	Relations        []*GameRelation        `protobuf:"bytes,2,rep,name=relations,proto3" json:"relations,omitempty"`
+	InverseRelations []*GameRelation        `protobuf:"bytes,3,rep,name=inverse_relations,json=inverseRelations,proto3" json:"inverse_relations,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RelatedGames) Reset() {
+	*x = RelatedGames{}
+	mi := &file_catalog_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelatedGames) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelatedGames) ProtoMessage() {}
+
+func (x *RelatedGames) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelatedGames.ProtoReflect.Descriptor instead.
+func (*RelatedGames) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RelatedGames) GetSeries() []*Game {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *RelatedGames) GetRelations() []*GameRelation {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+func (x *RelatedGames) GetInverseRelations() []*GameRelation {
+	if x != nil {
+		return x.InverseRelations
+	}
+	return nil
+}
+
+type Company struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Website       string                 `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Company) Reset() {
+	*x = Company{}
+	mi := &file_catalog_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Company) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Company) ProtoMessage() {}
+
+func (x *Company) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Company.ProtoReflect.Descriptor instead.
+func (*Company) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Company) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Company) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Company) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+type CreateGameRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Title              string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description        string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Developer          string                 `protobuf:"bytes,3,opt,name=developer,proto3" json:"developer,omitempty"`
+	Publisher          string                 `protobuf:"bytes,4,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	ReleaseDate        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	GenreIds           []uint32               `protobuf:"varint,6,rep,packed,name=genre_ids,json=genreIds,proto3" json:"genre_ids,omitempty"`
+	PlatformIds        []uint32               `protobuf:"varint,7,rep,packed,name=platform_ids,json=platformIds,proto3" json:"platform_ids,omitempty"`
+	Price              float64                `protobuf:"fixed64,8,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl           string                 `protobuf:"bytes,9,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Currency           string                 `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
+	Prices             []*Price               `protobuf:"bytes,11,rep,name=prices,proto3" json:"prices,omitempty"`
+	DeveloperIds       []uint32               `protobuf:"varint,12,rep,packed,name=developer_ids,json=developerIds,proto3" json:"developer_ids,omitempty"`
+	PublisherIds       []uint32               `protobuf:"varint,13,rep,packed,name=publisher_ids,json=publisherIds,proto3" json:"publisher_ids,omitempty"`
+	FranchiseId        *uint32                `protobuf:"varint,14,opt,name=franchise_id,json=franchiseId,proto3,oneof" json:"franchise_id,omitempty"`
+	Releases           []*Release             `protobuf:"bytes,15,rep,name=releases,proto3" json:"releases,omitempty"`
+	AgeRatings         []*AgeRating           `protobuf:"bytes,16,rep,name=age_ratings,json=ageRatings,proto3" json:"age_ratings,omitempty"`
+	SystemRequirements []*SystemRequirements  `protobuf:"bytes,17,rep,name=system_requirements,json=systemRequirements,proto3" json:"system_requirements,omitempty"`
+	Languages          []*GameLanguage        `protobuf:"bytes,18,rep,name=languages,proto3" json:"languages,omitempty"`
+	GameModes          []string               `protobuf:"bytes,19,rep,name=game_modes,json=gameModes,proto3" json:"game_modes,omitempty"`
+	MinPlayers         int32                  `protobuf:"varint,20,opt,name=min_players,json=minPlayers,proto3" json:"min_players,omitempty"`
+	MaxPlayers         int32                  `protobuf:"varint,21,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	Accessibility      []string               `protobuf:"bytes,22,rep,name=accessibility,proto3" json:"accessibility,omitempty"`
+	ExternalIds        []*ExternalID          `protobuf:"bytes,23,rep,name=external_ids,json=externalIds,proto3" json:"external_ids,omitempty"`
+	AverageRating      float64                `protobuf:"fixed64,24,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateGameRequest) Reset() {
+	*x = CreateGameRequest{}
+	mi := &file_catalog_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGameRequest) ProtoMessage() {}
+
+func (x *CreateGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGameRequest.ProtoReflect.Descriptor instead.
+func (*CreateGameRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateGameRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateGameRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateGameRequest) GetDeveloper() string {
+	if x != nil {
+		return x.Developer
+	}
+	return ""
+}
+
+func (x *CreateGameRequest) GetPublisher() string {
+	if x != nil {
+		return x.Publisher
+	}
+	return ""
+}
+
+func (x *CreateGameRequest) GetReleaseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+func (x *CreateGameRequest) GetGenreIds() []uint32 {
+	if x != nil {
+		return x.GenreIds
+	}
+	return nil
+}
+
+func (x *CreateGameRequest) GetPlatformIds() []uint32 {
+	if x != nil {
+		return x.PlatformIds
+	}
+	return nil
+}
+
+func (x *CreateGameRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateGameRequest) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *CreateGameRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateGameRequest) GetPrices() []*Price {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *CreateGameRequest) GetDeveloperIds() []uint32 {
+	if x != nil {
+		return x.DeveloperIds
+	}
+	return nil
+}
+
+func (x *CreateGameRequest) GetPublisherIds() []uint32 {
+	if x != nil {
+		return x.PublisherIds
+	}
+	return nil
+}
+
+func (x *CreateGameRequest) GetFranchiseId() uint32 {
+	if x != nil && x.FranchiseId != nil {
+		return *x.FranchiseId
+	}
+	return 0
+}
+
+func (x *CreateGameRequest) GetReleases() []*Release {
+	if x != nil {
+		return x.Releases
+	}
+	return nil
+}
+
+func (x *CreateGameRequest) GetAgeRatings() []*AgeRating {
+	if x != nil {
+		return x.AgeRatings
+	}
+	return nil
+}
+
+func (x *CreateGameRequest) GetSystemRequirements() []*SystemRequirements {
+	if x != nil {
+		return x.SystemRequirements
+	}
+	return nil
+}
+
+func (x *CreateGameRequest) GetLanguages() []*GameLanguage {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *CreateGameRequest) GetGameModes() []string {
+	if x != nil {
+		return x.GameModes
+	}
+	return nil
+}
+
+func (x *CreateGameRequest) GetMinPlayers() int32 {
+	if x != nil {
+		return x.MinPlayers
+	}
+	return 0
+}
+
+func (x *CreateGameRequest) GetMaxPlayers() int32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+func (x *CreateGameRequest) GetAccessibility() []string {
+	if x != nil {
+		return x.Accessibility
+	}
+	return nil
+}
+
+func (x *CreateGameRequest) GetExternalIds() []*ExternalID {
+	if x != nil {
+		return x.ExternalIds
+	}
+	return nil
+}
+
+func (x *CreateGameRequest) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+type GetGameRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Numeric ID, UUID or slug (current or former); used instead of id when set.
+	Ref string `protobuf:"bytes,4,opt,name=ref,proto3" json:"ref,omitempty"`
+	// Optional embeddings: "related", "translations", "media".
+	Include []string `protobuf:"bytes,2,rep,name=include,proto3" json:"include,omitempty"`
+	// Preferred locales, most preferred first. Each one falls back to its base
+	// language, then to the catalog's default locale.
+	Languages     []string `protobuf:"bytes,3,rep,name=languages,proto3" json:"languages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameRequest) Reset() {
+	*x = GetGameRequest{}
+	mi := &file_catalog_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameRequest) ProtoMessage() {}
+
+func (x *GetGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameRequest.ProtoReflect.Descriptor instead.
+func (*GetGameRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetGameRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetGameRequest) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *GetGameRequest) GetInclude() []string {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+func (x *GetGameRequest) GetLanguages() []string {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+type GetGameByExternalIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Languages     []string               `protobuf:"bytes,3,rep,name=languages,proto3" json:"languages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameByExternalIDRequest) Reset() {
+	*x = GetGameByExternalIDRequest{}
+	mi := &file_catalog_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameByExternalIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameByExternalIDRequest) ProtoMessage() {}
+
+func (x *GetGameByExternalIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameByExternalIDRequest.ProtoReflect.Descriptor instead.
+func (*GetGameByExternalIDRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetGameByExternalIDRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *GetGameByExternalIDRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *GetGameByExternalIDRequest) GetLanguages() []string {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+type UpdateGameRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title              string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description        string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Developer          string                 `protobuf:"bytes,4,opt,name=developer,proto3" json:"developer,omitempty"`
+	Publisher          string                 `protobuf:"bytes,5,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	ReleaseDate        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	GenreIds           []uint32               `protobuf:"varint,7,rep,packed,name=genre_ids,json=genreIds,proto3" json:"genre_ids,omitempty"`
+	PlatformIds        []uint32               `protobuf:"varint,8,rep,packed,name=platform_ids,json=platformIds,proto3" json:"platform_ids,omitempty"`
+	Price              float64                `protobuf:"fixed64,9,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl           string                 `protobuf:"bytes,10,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Currency           string                 `protobuf:"bytes,11,opt,name=currency,proto3" json:"currency,omitempty"`
+	Prices             []*Price               `protobuf:"bytes,12,rep,name=prices,proto3" json:"prices,omitempty"`
+	DeveloperIds       []uint32               `protobuf:"varint,13,rep,packed,name=developer_ids,json=developerIds,proto3" json:"developer_ids,omitempty"`
+	PublisherIds       []uint32               `protobuf:"varint,14,rep,packed,name=publisher_ids,json=publisherIds,proto3" json:"publisher_ids,omitempty"`
+	FranchiseId        *uint32                `protobuf:"varint,15,opt,name=franchise_id,json=franchiseId,proto3,oneof" json:"franchise_id,omitempty"`
+	Releases           []*Release             `protobuf:"bytes,16,rep,name=releases,proto3" json:"releases,omitempty"`
+	AgeRatings         []*AgeRating           `protobuf:"bytes,17,rep,name=age_ratings,json=ageRatings,proto3" json:"age_ratings,omitempty"`
+	SystemRequirements []*SystemRequirements  `protobuf:"bytes,18,rep,name=system_requirements,json=systemRequirements,proto3" json:"system_requirements,omitempty"`
+	Languages          []*GameLanguage        `protobuf:"bytes,19,rep,name=languages,proto3" json:"languages,omitempty"`
+	GameModes          []string               `protobuf:"bytes,20,rep,name=game_modes,json=gameModes,proto3" json:"game_modes,omitempty"`
+	MinPlayers         int32                  `protobuf:"varint,21,opt,name=min_players,json=minPlayers,proto3" json:"min_players,omitempty"`
+	MaxPlayers         int32                  `protobuf:"varint,22,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	Accessibility      []string               `protobuf:"bytes,23,rep,name=accessibility,proto3" json:"accessibility,omitempty"`
+	ExternalIds        []*ExternalID          `protobuf:"bytes,24,rep,name=external_ids,json=externalIds,proto3" json:"external_ids,omitempty"`
+	// Update replaces the whole game: an unset rating is stored as 0.
+	AverageRating float64 `protobuf:"fixed64,25,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGameRequest) Reset() {
+	*x = UpdateGameRequest{}
+	mi := &file_catalog_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGameRequest) ProtoMessage() {}
+
+func (x *UpdateGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGameRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGameRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateGameRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateGameRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateGameRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateGameRequest) GetDeveloper() string {
+	if x != nil {
+		return x.Developer
+	}
+	return ""
+}
+
+func (x *UpdateGameRequest) GetPublisher() string {
+	if x != nil {
+		return x.Publisher
+	}
+	return ""
+}
+
+func (x *UpdateGameRequest) GetReleaseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+func (x *UpdateGameRequest) GetGenreIds() []uint32 {
+	if x != nil {
+		return x.GenreIds
+	}
+	return nil
+}
+
+func (x *UpdateGameRequest) GetPlatformIds() []uint32 {
+	if x != nil {
+		return x.PlatformIds
+	}
+	return nil
+}
+
+func (x *UpdateGameRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *UpdateGameRequest) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *UpdateGameRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateGameRequest) GetPrices() []*Price {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *UpdateGameRequest) GetDeveloperIds() []uint32 {
+	if x != nil {
+		return x.DeveloperIds
+	}
+	return nil
+}
+
+func (x *UpdateGameRequest) GetPublisherIds() []uint32 {
+	if x != nil {
+		return x.PublisherIds
+	}
+	return nil
+}
+
+func (x *UpdateGameRequest) GetFranchiseId() uint32 {
+	if x != nil && x.FranchiseId != nil {
+		return *x.FranchiseId
+	}
+	return 0
+}
+
+func (x *UpdateGameRequest) GetReleases() []*Release {
+	if x != nil {
+		return x.Releases
+	}
+	return nil
+}
+
+func (x *UpdateGameRequest) GetAgeRatings() []*AgeRating {
+	if x != nil {
+		return x.AgeRatings
+	}
+	return nil
+}
+
+func (x *UpdateGameRequest) GetSystemRequirements() []*SystemRequirements {
+	if x != nil {
+		return x.SystemRequirements
+	}
+	return nil
+}
+
+func (x *UpdateGameRequest) GetLanguages() []*GameLanguage {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *UpdateGameRequest) GetGameModes() []string {
+	if x != nil {
+		return x.GameModes
+	}
+	return nil
+}
+
+func (x *UpdateGameRequest) GetMinPlayers() int32 {
+	if x != nil {
+		return x.MinPlayers
+	}
+	return 0
+}
+
+func (x *UpdateGameRequest) GetMaxPlayers() int32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+func (x *UpdateGameRequest) GetAccessibility() []string {
+	if x != nil {
+		return x.Accessibility
+	}
+	return nil
+}
+
+func (x *UpdateGameRequest) GetExternalIds() []*ExternalID {
+	if x != nil {
+		return x.ExternalIds
+	}
+	return nil
+}
+
+func (x *UpdateGameRequest) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+type ListGameTranslationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        uint32                 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGameTranslationsRequest) Reset() {
+	*x = ListGameTranslationsRequest{}
+	mi := &file_catalog_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGameTranslationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGameTranslationsRequest) ProtoMessage() {}
+
+func (x *ListGameTranslationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGameTranslationsRequest.ProtoReflect.Descriptor instead.
+func (*ListGameTranslationsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListGameTranslationsRequest) GetGameId() uint32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type GameTranslationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Translations  []*GameTranslation     `protobuf:"bytes,1,rep,name=translations,proto3" json:"translations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameTranslationsResponse) Reset() {
+	*x = GameTranslationsResponse{}
+	mi := &file_catalog_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameTranslationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameTranslationsResponse) ProtoMessage() {}
+
+func (x *GameTranslationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameTranslationsResponse.ProtoReflect.Descriptor instead.
+func (*GameTranslationsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GameTranslationsResponse) GetTranslations() []*GameTranslation {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
+// Creates or replaces the translation of a game in a locale.
+type SetGameTranslationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        uint32                 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGameTranslationRequest) Reset() {
+	*x = SetGameTranslationRequest{}
+	mi := &file_catalog_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGameTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGameTranslationRequest) ProtoMessage() {}
+
+func (x *SetGameTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGameTranslationRequest.ProtoReflect.Descriptor instead.
+func (*SetGameTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetGameTranslationRequest) GetGameId() uint32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *SetGameTranslationRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *SetGameTranslationRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SetGameTranslationRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type DeleteGameTranslationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        uint32                 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGameTranslationRequest) Reset() {
+	*x = DeleteGameTranslationRequest{}
+	mi := &file_catalog_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGameTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGameTranslationRequest) ProtoMessage() {}
+
+func (x *DeleteGameTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGameTranslationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGameTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteGameTranslationRequest) GetGameId() uint32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *DeleteGameTranslationRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type ListGameMediaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        uint32                 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGameMediaRequest) Reset() {
+	*x = ListGameMediaRequest{}
+	mi := &file_catalog_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGameMediaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGameMediaRequest) ProtoMessage() {}
+
+func (x *ListGameMediaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGameMediaRequest.ProtoReflect.Descriptor instead.
+func (*ListGameMediaRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListGameMediaRequest) GetGameId() uint32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type GameMediaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Media         []*GameMedia           `protobuf:"bytes,1,rep,name=media,proto3" json:"media,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameMediaResponse) Reset() {
+	*x = GameMediaResponse{}
+	mi := &file_catalog_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameMediaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameMediaResponse) ProtoMessage() {}
+
+func (x *GameMediaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameMediaResponse.ProtoReflect.Descriptor instead.
+func (*GameMediaResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GameMediaResponse) GetMedia() []*GameMedia {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+// Appends an asset to the gallery. It becomes the primary asset of its type
+// when primary is set or when it is the first one of its type.
+type AddGameMediaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        uint32                 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Caption       string                 `protobuf:"bytes,4,opt,name=caption,proto3" json:"caption,omitempty"`
+	Width         int32                  `protobuf:"varint,5,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Primary       bool                   `protobuf:"varint,7,opt,name=primary,proto3" json:"primary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGameMediaRequest) Reset() {
+	*x = AddGameMediaRequest{}
+	mi := &file_catalog_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGameMediaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGameMediaRequest) ProtoMessage() {}
+
+func (x *AddGameMediaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGameMediaRequest.ProtoReflect.Descriptor instead.
+func (*AddGameMediaRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AddGameMediaRequest) GetGameId() uint32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *AddGameMediaRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AddGameMediaRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AddGameMediaRequest) GetCaption() string {
+	if x != nil {
+		return x.Caption
+	}
+	return ""
+}
+
+func (x *AddGameMediaRequest) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *AddGameMediaRequest) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *AddGameMediaRequest) GetPrimary() bool {
+	if x != nil {
+		return x.Primary
+	}
+	return false
+}
+
+// media_ids must list every asset of the game exactly once, in the new order.
+type ReorderGameMediaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        uint32                 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	MediaIds      []uint32               `protobuf:"varint,2,rep,packed,name=media_ids,json=mediaIds,proto3" json:"media_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderGameMediaRequest) Reset() {
+	*x = ReorderGameMediaRequest{}
+	mi := &file_catalog_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderGameMediaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderGameMediaRequest) ProtoMessage() {}
+
+func (x *ReorderGameMediaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderGameMediaRequest.ProtoReflect.Descriptor instead.
+func (*ReorderGameMediaRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReorderGameMediaRequest) GetGameId() uint32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *ReorderGameMediaRequest) GetMediaIds() []uint32 {
+	if x != nil {
+		return x.MediaIds
+	}
+	return nil
+}
+
+type RemoveGameMediaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        uint32                 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	MediaId       uint32                 `protobuf:"varint,2,opt,name=media_id,json=mediaId,proto3" json:"media_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGameMediaRequest) Reset() {
+	*x = RemoveGameMediaRequest{}
+	mi := &file_catalog_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGameMediaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGameMediaRequest) ProtoMessage() {}
+
+func (x *RemoveGameMediaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGameMediaRequest.ProtoReflect.Descriptor instead.
+func (*RemoveGameMediaRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RemoveGameMediaRequest) GetGameId() uint32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *RemoveGameMediaRequest) GetMediaId() uint32 {
+	if x != nil {
+		return x.MediaId
+	}
+	return 0
+}
+
+// Adds the caller's vote for the tag on the game; an unknown tag is suggested
+// for moderation first. Each voter counts once per game and tag, so voting
+// again has no effect.
+type VoteGameTagRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId uint32                 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Opaque voter identifier (user or device ID). Defaults to the caller's
+	// address; only its SHA-256 digest is stored.
+	VoterId       string `protobuf:"bytes,3,opt,name=voter_id,json=voterId,proto3" json:"voter_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoteGameTagRequest) Reset() {
+	*x = VoteGameTagRequest{}
+	mi := &file_catalog_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteGameTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteGameTagRequest) ProtoMessage() {}
+
+func (x *VoteGameTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteGameTagRequest.ProtoReflect.Descriptor instead.
+func (*VoteGameTagRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *VoteGameTagRequest) GetGameId() uint32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *VoteGameTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VoteGameTagRequest) GetVoterId() string {
+	if x != nil {
+		return x.VoterId
+	}
+	return ""
+}
+
+// Removes the caller's own vote; the tag leaves the game with its last vote.
+type RemoveGameTagVoteRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId uint32                 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	TagId  uint32                 `protobuf:"varint,2,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	// Same voter identifier as in VoteGameTagRequest.
+	VoterId       string `protobuf:"bytes,3,opt,name=voter_id,json=voterId,proto3" json:"voter_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGameTagVoteRequest) Reset() {
+	*x = RemoveGameTagVoteRequest{}
+	mi := &file_catalog_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGameTagVoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGameTagVoteRequest) ProtoMessage() {}
+
+func (x *RemoveGameTagVoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGameTagVoteRequest.ProtoReflect.Descriptor instead.
+func (*RemoveGameTagVoteRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RemoveGameTagVoteRequest) GetGameId() uint32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *RemoveGameTagVoteRequest) GetTagId() uint32 {
+	if x != nil {
+		return x.TagId
+	}
+	return 0
+}
+
+func (x *RemoveGameTagVoteRequest) GetVoterId() string {
+	if x != nil {
+		return x.VoterId
+	}
+	return ""
+}
+
+type DeleteGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGameRequest) Reset() {
+	*x = DeleteGameRequest{}
+	mi := &file_catalog_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGameRequest) ProtoMessage() {}
+
+func (x *DeleteGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGameRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGameRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteGameRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreGameRequest) Reset() {
+	*x = RestoreGameRequest{}
+	mi := &file_catalog_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreGameRequest) ProtoMessage() {}
+
+func (x *RestoreGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreGameRequest.ProtoReflect.Descriptor instead.
+func (*RestoreGameRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RestoreGameRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GameGenreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        uint32                 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	GenreId       uint32                 `protobuf:"varint,2,opt,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameGenreRequest) Reset() {
+	*x = GameGenreRequest{}
+	mi := &file_catalog_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameGenreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameGenreRequest) ProtoMessage() {}
+
+func (x *GameGenreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameGenreRequest.ProtoReflect.Descriptor instead.
+func (*GameGenreRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GameGenreRequest) GetGameId() uint32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *GameGenreRequest) GetGenreId() uint32 {
+	if x != nil {
+		return x.GenreId
+	}
+	return 0
+}
+
+type GamePlatformRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        uint32                 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlatformId    uint32                 `protobuf:"varint,2,opt,name=platform_id,json=platformId,proto3" json:"platform_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GamePlatformRequest) Reset() {
+	*x = GamePlatformRequest{}
+	mi := &file_catalog_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GamePlatformRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GamePlatformRequest) ProtoMessage() {}
+
+func (x *GamePlatformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GamePlatformRequest.ProtoReflect.Descriptor instead.
+func (*GamePlatformRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GamePlatformRequest) GetGameId() uint32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *GamePlatformRequest) GetPlatformId() uint32 {
+	if x != nil {
+		return x.PlatformId
+	}
+	return 0
+}
+
+type AddGameRelationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        uint32                 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	RelatedGameId uint32                 `protobuf:"varint,2,opt,name=related_game_id,json=relatedGameId,proto3" json:"related_game_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGameRelationRequest) Reset() {
+	*x = AddGameRelationRequest{}
+	mi := &file_catalog_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGameRelationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGameRelationRequest) ProtoMessage() {}
+
+func (x *AddGameRelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGameRelationRequest.ProtoReflect.Descriptor instead.
+func (*AddGameRelationRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AddGameRelationRequest) GetGameId() uint32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *AddGameRelationRequest) GetRelatedGameId() uint32 {
+	if x != nil {
+		return x.RelatedGameId
+	}
+	return 0
+}
+
+func (x *AddGameRelationRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type RemoveGameRelationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        uint32                 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	RelationId    uint32                 `protobuf:"varint,2,opt,name=relation_id,json=relationId,proto3" json:"relation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGameRelationRequest) Reset() {
+	*x = RemoveGameRelationRequest{}
+	mi := &file_catalog_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGameRelationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGameRelationRequest) ProtoMessage() {}
+
+func (x *RemoveGameRelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGameRelationRequest.ProtoReflect.Descriptor instead.
+func (*RemoveGameRelationRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RemoveGameRelationRequest) GetGameId() uint32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *RemoveGameRelationRequest) GetRelationId() uint32 {
+	if x != nil {
+		return x.RelationId
+	}
+	return 0
+}
+
+type ListGamesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Title     string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Developer string                 `protobuf:"bytes,2,opt,name=developer,proto3" json:"developer,omitempty"`
+	Publisher string                 `protobuf:"bytes,3,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	Genres    []string               `protobuf:"bytes,4,rep,name=genres,proto3" json:"genres,omitempty"`
+	Platforms []string               `protobuf:"bytes,5,rep,name=platforms,proto3" json:"platforms,omitempty"`
+	// Games whose base price, or any platform or region price (in currency when
+	// set), is within the range. Sorting by price uses the base price.
+	MinPrice       *float64 `protobuf:"fixed64,6,opt,name=min_price,json=minPrice,proto3,oneof" json:"min_price,omitempty"`
+	MaxPrice       *float64 `protobuf:"fixed64,7,opt,name=max_price,json=maxPrice,proto3,oneof" json:"max_price,omitempty"`
+	MinRating      *float64 `protobuf:"fixed64,8,opt,name=min_rating,json=minRating,proto3,oneof" json:"min_rating,omitempty"`
+	SortBy         string   `protobuf:"bytes,9,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortOrder      string   `protobuf:"bytes,10,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	Page           int32    `protobuf:"varint,11,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize       int32    `protobuf:"varint,12,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Currency       string   `protobuf:"bytes,13,opt,name=currency,proto3" json:"currency,omitempty"`
+	IncludeDeleted bool     `protobuf:"varint,14,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	Q              string   `protobuf:"bytes,15,opt,name=q,proto3" json:"q,omitempty"`
+	Fuzzy          bool     `protobuf:"varint,16,opt,name=fuzzy,proto3" json:"fuzzy,omitempty"`
+	// Opaque keyset cursor taken from ListGamesResponse.next_cursor. When set,
+	// page is ignored and total_count/total_pages are not computed.
+	Cursor      string   `protobuf:"bytes,17,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Facets      bool     `protobuf:"varint,18,opt,name=facets,proto3" json:"facets,omitempty"`
+	GenreIds    []uint32 `protobuf:"varint,19,rep,packed,name=genre_ids,json=genreIds,proto3" json:"genre_ids,omitempty"`
+	PlatformIds []uint32 `protobuf:"varint,20,rep,packed,name=platform_ids,json=platformIds,proto3" json:"platform_ids,omitempty"`
+	// "any" (default) or "all".
+	GenreMatch       string   `protobuf:"bytes,21,opt,name=genre_match,json=genreMatch,proto3" json:"genre_match,omitempty"`
+	PlatformMatch    string   `protobuf:"bytes,22,opt,name=platform_match,json=platformMatch,proto3" json:"platform_match,omitempty"`
+	ExcludeGenres    []string `protobuf:"bytes,23,rep,name=exclude_genres,json=excludeGenres,proto3" json:"exclude_genres,omitempty"`
+	ExcludePlatforms []string `protobuf:"bytes,24,rep,name=exclude_platforms,json=excludePlatforms,proto3" json:"exclude_platforms,omitempty"`
+	DeveloperIds     []uint32 `protobuf:"varint,25,rep,packed,name=developer_ids,json=developerIds,proto3" json:"developer_ids,omitempty"`
+	PublisherIds     []uint32 `protobuf:"varint,26,rep,packed,name=publisher_ids,json=publisherIds,proto3" json:"publisher_ids,omitempty"`
+	// Games credited to any of these companies, as developer or publisher.
+	CompanyIds []uint32 `protobuf:"varint,27,rep,packed,name=company_ids,json=companyIds,proto3" json:"company_ids,omitempty"`
+	// Games with at least one release matching all of the following.
+	ReleasePlatformId *uint32                `protobuf:"varint,28,opt,name=release_platform_id,json=releasePlatformId,proto3,oneof" json:"release_platform_id,omitempty"`
+	ReleaseRegion     string                 `protobuf:"bytes,29,opt,name=release_region,json=releaseRegion,proto3" json:"release_region,omitempty"`
+	ReleasedAfter     *timestamppb.Timestamp `protobuf:"bytes,30,opt,name=released_after,json=releasedAfter,proto3" json:"released_after,omitempty"`
+	ReleasedBefore    *timestamppb.Timestamp `protobuf:"bytes,31,opt,name=released_before,json=releasedBefore,proto3" json:"released_before,omitempty"`
+	ReleaseStatus     string                 `protobuf:"bytes,32,opt,name=release_status,json=releaseStatus,proto3" json:"release_status,omitempty"`
+	// Announced or delayed releases whose date, at its precision, is still in
+	// the future or unknown.
+	Upcoming bool `protobuf:"varint,33,opt,name=upcoming,proto3" json:"upcoming,omitempty"`
+	// Games rated for at most max_age, by rating_board when given, otherwise by
+	// every board that rated them. Unrated games are excluded.
+	MaxAge      *int32 `protobuf:"varint,34,opt,name=max_age,json=maxAge,proto3,oneof" json:"max_age,omitempty"`
+	RatingBoard string `protobuf:"bytes,35,opt,name=rating_board,json=ratingBoard,proto3" json:"rating_board,omitempty"`
+	// Preferred locales for titles and descriptions, as in GetGameRequest.
+	Languages []string `protobuf:"bytes,36,rep,name=languages,proto3" json:"languages,omitempty"`
+	// Optional embeddings: "media".
+	Include []string `protobuf:"bytes,37,rep,name=include,proto3" json:"include,omitempty"`
+	// Games whose minimum configuration, on at least one platform, fits in this
+	// amount of memory / disk space (in GB).
+	MaxMemoryGb  *int32 `protobuf:"varint,38,opt,name=max_memory_gb,json=maxMemoryGb,proto3,oneof" json:"max_memory_gb,omitempty"`
+	MaxStorageGb *int32 `protobuf:"varint,39,opt,name=max_storage_gb,json=maxStorageGb,proto3,oneof" json:"max_storage_gb,omitempty"`
+	// Approved tag names; tag_match is "any" (default) or "all".
+	Tags     []string `protobuf:"bytes,40,rep,name=tags,proto3" json:"tags,omitempty"`
+	TagMatch string   `protobuf:"bytes,41,opt,name=tag_match,json=tagMatch,proto3" json:"tag_match,omitempty"`
+	// Every listed language, game mode and accessibility feature is required.
+	// A base language ("fr") also matches its regional variants ("fr-CA").
+	InterfaceLanguages []string `protobuf:"bytes,42,rep,name=interface_languages,json=interfaceLanguages,proto3" json:"interface_languages,omitempty"`
+	AudioLanguages     []string `protobuf:"bytes,43,rep,name=audio_languages,json=audioLanguages,proto3" json:"audio_languages,omitempty"`
+	SubtitleLanguages  []string `protobuf:"bytes,44,rep,name=subtitle_languages,json=subtitleLanguages,proto3" json:"subtitle_languages,omitempty"`
+	GameModes          []string `protobuf:"bytes,45,rep,name=game_modes,json=gameModes,proto3" json:"game_modes,omitempty"`
+	// Games playable by this many players.
+	Players       *int32   `protobuf:"varint,46,opt,name=players,proto3,oneof" json:"players,omitempty"`
+	Accessibility []string `protobuf:"bytes,47,rep,name=accessibility,proto3" json:"accessibility,omitempty"`
+	// Games with no identifier for this provider.
+	MissingExternalId  string   `protobuf:"bytes,48,opt,name=missing_external_id,json=missingExternalId,proto3" json:"missing_external_id,omitempty"`
+	ExcludeGenreIds    []uint32 `protobuf:"varint,49,rep,packed,name=exclude_genre_ids,json=excludeGenreIds,proto3" json:"exclude_genre_ids,omitempty"`
+	ExcludePlatformIds []uint32 `protobuf:"varint,50,rep,packed,name=exclude_platform_ids,json=excludePlatformIds,proto3" json:"exclude_platform_ids,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListGamesRequest) Reset() {
+	*x = ListGamesRequest{}
+	mi := &file_catalog_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGamesRequest) ProtoMessage() {}
+
+func (x *ListGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGamesRequest.ProtoReflect.Descriptor instead.
+func (*ListGamesRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListGamesRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetDeveloper() string {
+	if x != nil {
+		return x.Developer
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetPublisher() string {
+	if x != nil {
+		return x.Publisher
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetGenres() []string {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetPlatforms() []string {
+	if x != nil {
+		return x.Platforms
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetMinPrice() float64 {
+	if x != nil && x.MinPrice != nil {
+		return *x.MinPrice
+	}
+	return 0
+}
+
+func (x *ListGamesRequest) GetMaxPrice() float64 {
+	if x != nil && x.MaxPrice != nil {
+		return *x.MaxPrice
+	}
+	return 0
+}
+
+func (x *ListGamesRequest) GetMinRating() float64 {
+	if x != nil && x.MinRating != nil {
+		return *x.MinRating
+	}
+	return 0
+}
+
+func (x *ListGamesRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListGamesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListGamesRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *ListGamesRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetFuzzy() bool {
+	if x != nil {
+		return x.Fuzzy
+	}
+	return false
+}
+
+func (x *ListGamesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetFacets() bool {
+	if x != nil {
+		return x.Facets
+	}
+	return false
+}
+
+func (x *ListGamesRequest) GetGenreIds() []uint32 {
+	if x != nil {
+		return x.GenreIds
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetPlatformIds() []uint32 {
+	if x != nil {
+		return x.PlatformIds
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetGenreMatch() string {
+	if x != nil {
+		return x.GenreMatch
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetPlatformMatch() string {
+	if x != nil {
+		return x.PlatformMatch
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetExcludeGenres() []string {
+	if x != nil {
+		return x.ExcludeGenres
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetExcludePlatforms() []string {
+	if x != nil {
+		return x.ExcludePlatforms
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetDeveloperIds() []uint32 {
+	if x != nil {
+		return x.DeveloperIds
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetPublisherIds() []uint32 {
+	if x != nil {
+		return x.PublisherIds
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetCompanyIds() []uint32 {
+	if x != nil {
+		return x.CompanyIds
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetReleasePlatformId() uint32 {
+	if x != nil && x.ReleasePlatformId != nil {
+		return *x.ReleasePlatformId
+	}
+	return 0
+}
+
+func (x *ListGamesRequest) GetReleaseRegion() string {
+	if x != nil {
+		return x.ReleaseRegion
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetReleasedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleasedAfter
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetReleasedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleasedBefore
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetReleaseStatus() string {
+	if x != nil {
+		return x.ReleaseStatus
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetUpcoming() bool {
+	if x != nil {
+		return x.Upcoming
+	}
+	return false
+}
+
+func (x *ListGamesRequest) GetMaxAge() int32 {
+	if x != nil && x.MaxAge != nil {
+		return *x.MaxAge
+	}
+	return 0
+}
+
+func (x *ListGamesRequest) GetRatingBoard() string {
+	if x != nil {
+		return x.RatingBoard
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetLanguages() []string {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetInclude() []string {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetMaxMemoryGb() int32 {
+	if x != nil && x.MaxMemoryGb != nil {
+		return *x.MaxMemoryGb
+	}
+	return 0
+}
+
+func (x *ListGamesRequest) GetMaxStorageGb() int32 {
+	if x != nil && x.MaxStorageGb != nil {
+		return *x.MaxStorageGb
+	}
+	return 0
+}
+
+func (x *ListGamesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetTagMatch() string {
+	if x != nil {
+		return x.TagMatch
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetInterfaceLanguages() []string {
+	if x != nil {
+		return x.InterfaceLanguages
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetAudioLanguages() []string {
+	if x != nil {
+		return x.AudioLanguages
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetSubtitleLanguages() []string {
+	if x != nil {
+		return x.SubtitleLanguages
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetGameModes() []string {
+	if x != nil {
+		return x.GameModes
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetPlayers() int32 {
+	if x != nil && x.Players != nil {
+		return *x.Players
+	}
+	return 0
+}
+
+func (x *ListGamesRequest) GetAccessibility() []string {
+	if x != nil {
+		return x.Accessibility
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetMissingExternalId() string {
+	if x != nil {
+		return x.MissingExternalId
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetExcludeGenreIds() []uint32 {
+	if x != nil {
+		return x.ExcludeGenreIds
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetExcludePlatformIds() []uint32 {
+	if x != nil {
+		return x.ExcludePlatformIds
+	}
+	return nil
+}
+
+type ListGamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Games         []*Game                `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,6,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Facets        *GameFacets            `protobuf:"bytes,7,opt,name=facets,proto3" json:"facets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGamesResponse) Reset() {
+	*x = ListGamesResponse{}
+	mi := &file_catalog_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGamesResponse) ProtoMessage() {}
+
+func (x *ListGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGamesResponse.ProtoReflect.Descriptor instead.
+func (*ListGamesResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListGamesResponse) GetGames() []*Game {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+func (x *ListGamesResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListGamesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListGamesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListGamesResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *ListGamesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListGamesResponse) GetFacets() *GameFacets {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+type FacetCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetCount) Reset() {
+	*x = FacetCount{}
+	mi := &file_catalog_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetCount) ProtoMessage() {}
+
+func (x *FacetCount) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetCount.ProtoReflect.Descriptor instead.
+func (*FacetCount) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *FacetCount) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FacetCount) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FacetCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GameFacets struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Genres        []*FacetCount          `protobuf:"bytes,1,rep,name=genres,proto3" json:"genres,omitempty"`
+	Platforms     []*FacetCount          `protobuf:"bytes,2,rep,name=platforms,proto3" json:"platforms,omitempty"`
+	Developers    []*FacetCount          `protobuf:"bytes,3,rep,name=developers,proto3" json:"developers,omitempty"`
+	Publishers    []*FacetCount          `protobuf:"bytes,4,rep,name=publishers,proto3" json:"publishers,omitempty"`
+	Ratings       []*FacetCount          `protobuf:"bytes,5,rep,name=ratings,proto3" json:"ratings,omitempty"`
+	Tags          []*FacetCount          `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameFacets) Reset() {
+	*x = GameFacets{}
+	mi := &file_catalog_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameFacets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameFacets) ProtoMessage() {}
+
+func (x *GameFacets) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameFacets.ProtoReflect.Descriptor instead.
+func (*GameFacets) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GameFacets) GetGenres() []*FacetCount {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *GameFacets) GetPlatforms() []*FacetCount {
+	if x != nil {
+		return x.Platforms
+	}
+	return nil
+}
+
+func (x *GameFacets) GetDevelopers() []*FacetCount {
+	if x != nil {
+		return x.Developers
+	}
+	return nil
+}
+
+func (x *GameFacets) GetPublishers() []*FacetCount {
+	if x != nil {
+		return x.Publishers
+	}
+	return nil
+}
+
+func (x *GameFacets) GetRatings() []*FacetCount {
+	if x != nil {
+		return x.Ratings
+	}
+	return nil
+}
+
+func (x *GameFacets) GetTags() []*FacetCount {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type AutocompleteTitlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutocompleteTitlesRequest) Reset() {
+	*x = AutocompleteTitlesRequest{}
+	mi := &file_catalog_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutocompleteTitlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutocompleteTitlesRequest) ProtoMessage() {}
+
+func (x *AutocompleteTitlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutocompleteTitlesRequest.ProtoReflect.Descriptor instead.
+func (*AutocompleteTitlesRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AutocompleteTitlesRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *AutocompleteTitlesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TitleSuggestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Similarity    float64                `protobuf:"fixed64,3,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TitleSuggestion) Reset() {
+	*x = TitleSuggestion{}
+	mi := &file_catalog_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TitleSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TitleSuggestion) ProtoMessage() {}
+
+func (x *TitleSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TitleSuggestion.ProtoReflect.Descriptor instead.
+func (*TitleSuggestion) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TitleSuggestion) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TitleSuggestion) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TitleSuggestion) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+type AutocompleteTitlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*TitleSuggestion     `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutocompleteTitlesResponse) Reset() {
+	*x = AutocompleteTitlesResponse{}
+	mi := &file_catalog_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutocompleteTitlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutocompleteTitlesResponse) ProtoMessage() {}
+
+func (x *AutocompleteTitlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutocompleteTitlesResponse.ProtoReflect.Descriptor instead.
+func (*AutocompleteTitlesResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AutocompleteTitlesResponse) GetSuggestions() []*TitleSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type CreateGenreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGenreRequest) Reset() {
+	*x = CreateGenreRequest{}
+	mi := &file_catalog_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGenreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGenreRequest) ProtoMessage() {}
+
+func (x *CreateGenreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGenreRequest.ProtoReflect.Descriptor instead.
+func (*CreateGenreRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateGenreRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GenresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Genres        []*Genre               `protobuf:"bytes,1,rep,name=genres,proto3" json:"genres,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenresResponse) Reset() {
+	*x = GenresResponse{}
+	mi := &file_catalog_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenresResponse) ProtoMessage() {}
+
+func (x *GenresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenresResponse.ProtoReflect.Descriptor instead.
+func (*GenresResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GenresResponse) GetGenres() []*Genre {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+type GetGenreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGenreRequest) Reset() {
+	*x = GetGenreRequest{}
+	mi := &file_catalog_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGenreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGenreRequest) ProtoMessage() {}
+
+func (x *GetGenreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGenreRequest.ProtoReflect.Descriptor instead.
+func (*GetGenreRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetGenreRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateGenreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGenreRequest) Reset() {
+	*x = UpdateGenreRequest{}
+	mi := &file_catalog_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGenreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGenreRequest) ProtoMessage() {}
+
+func (x *UpdateGenreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGenreRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGenreRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdateGenreRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateGenreRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteGenreRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Removes the genre from the games that still reference it instead of
+	// rejecting the deletion.
+	Cascade       bool `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGenreRequest) Reset() {
+	*x = DeleteGenreRequest{}
+	mi := &file_catalog_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGenreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGenreRequest) ProtoMessage() {}
+
+func (x *DeleteGenreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGenreRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGenreRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeleteGenreRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteGenreRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+// Folds source_id into target_id: games linked to the source are relinked to
+// the target, then the source is deleted.
+type MergeGenresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      uint32                 `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TargetId      uint32                 `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeGenresRequest) Reset() {
+	*x = MergeGenresRequest{}
+	mi := &file_catalog_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeGenresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeGenresRequest) ProtoMessage() {}
+
+func (x *MergeGenresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeGenresRequest.ProtoReflect.Descriptor instead.
+func (*MergeGenresRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *MergeGenresRequest) GetSourceId() uint32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *MergeGenresRequest) GetTargetId() uint32 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+type CreatePlatformRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePlatformRequest) Reset() {
+	*x = CreatePlatformRequest{}
+	mi := &file_catalog_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePlatformRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlatformRequest) ProtoMessage() {}
+
+func (x *CreatePlatformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlatformRequest.ProtoReflect.Descriptor instead.
+func (*CreatePlatformRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CreatePlatformRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PlatformsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Platforms     []*Platform            `protobuf:"bytes,1,rep,name=platforms,proto3" json:"platforms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlatformsResponse) Reset() {
+	*x = PlatformsResponse{}
+	mi := &file_catalog_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlatformsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlatformsResponse) ProtoMessage() {}
+
+func (x *PlatformsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlatformsResponse.ProtoReflect.Descriptor instead.
+func (*PlatformsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PlatformsResponse) GetPlatforms() []*Platform {
+	if x != nil {
+		return x.Platforms
+	}
+	return nil
+}
+
+type GetPlatformRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlatformRequest) Reset() {
+	*x = GetPlatformRequest{}
+	mi := &file_catalog_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlatformRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlatformRequest) ProtoMessage() {}
+
+func (x *GetPlatformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlatformRequest.ProtoReflect.Descriptor instead.
+func (*GetPlatformRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetPlatformRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdatePlatformRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePlatformRequest) Reset() {
+	*x = UpdatePlatformRequest{}
+	mi := &file_catalog_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlatformRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlatformRequest) ProtoMessage() {}
+
+func (x *UpdatePlatformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlatformRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePlatformRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UpdatePlatformRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdatePlatformRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeletePlatformRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Removes the platform from the games (and their platform-specific prices)
+	// that still reference it instead of rejecting the deletion.
+	Cascade       bool `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlatformRequest) Reset() {
+	*x = DeletePlatformRequest{}
+	mi := &file_catalog_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlatformRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlatformRequest) ProtoMessage() {}
+
+func (x *DeletePlatformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlatformRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlatformRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DeletePlatformRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeletePlatformRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+type MergePlatformsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      uint32                 `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TargetId      uint32                 `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePlatformsRequest) Reset() {
+	*x = MergePlatformsRequest{}
+	mi := &file_catalog_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePlatformsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePlatformsRequest) ProtoMessage() {}
+
+func (x *MergePlatformsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePlatformsRequest.ProtoReflect.Descriptor instead.
+func (*MergePlatformsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *MergePlatformsRequest) GetSourceId() uint32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *MergePlatformsRequest) GetTargetId() uint32 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+type CreateCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Website       string                 `protobuf:"bytes,2,opt,name=website,proto3" json:"website,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCompanyRequest) Reset() {
+	*x = CreateCompanyRequest{}
+	mi := &file_catalog_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompanyRequest) ProtoMessage() {}
+
+func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompanyRequest.ProtoReflect.Descriptor instead.
+func (*CreateCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreateCompanyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCompanyRequest) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+type CompaniesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Companies     []*Company             `protobuf:"bytes,1,rep,name=companies,proto3" json:"companies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompaniesResponse) Reset() {
+	*x = CompaniesResponse{}
+	mi := &file_catalog_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompaniesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompaniesResponse) ProtoMessage() {}
+
+func (x *CompaniesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompaniesResponse.ProtoReflect.Descriptor instead.
+func (*CompaniesResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CompaniesResponse) GetCompanies() []*Company {
+	if x != nil {
+		return x.Companies
+	}
+	return nil
+}
+
+type GetCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyRequest) Reset() {
+	*x = GetCompanyRequest{}
+	mi := &file_catalog_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyRequest) ProtoMessage() {}
+
+func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetCompanyRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Website       string                 `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCompanyRequest) Reset() {
+	*x = UpdateCompanyRequest{}
+	mi := &file_catalog_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompanyRequest) ProtoMessage() {}
+
+func (x *UpdateCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompanyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateCompanyRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCompanyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCompanyRequest) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+type DeleteCompanyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Removes the company from the games that still credit it instead of
+	// rejecting the deletion.
+	Cascade       bool `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCompanyRequest) Reset() {
+	*x = DeleteCompanyRequest{}
+	mi := &file_catalog_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCompanyRequest) ProtoMessage() {}
+
+func (x *DeleteCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCompanyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeleteCompanyRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteCompanyRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+type CreateFranchiseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFranchiseRequest) Reset() {
+	*x = CreateFranchiseRequest{}
+	mi := &file_catalog_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFranchiseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFranchiseRequest) ProtoMessage() {}
+
+func (x *CreateFranchiseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFranchiseRequest.ProtoReflect.Descriptor instead.
+func (*CreateFranchiseRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CreateFranchiseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateFranchiseRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type FranchisesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Franchises    []*Franchise           `protobuf:"bytes,1,rep,name=franchises,proto3" json:"franchises,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FranchisesResponse) Reset() {
+	*x = FranchisesResponse{}
+	mi := &file_catalog_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FranchisesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FranchisesResponse) ProtoMessage() {}
+
+func (x *FranchisesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FranchisesResponse.ProtoReflect.Descriptor instead.
+func (*FranchisesResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *FranchisesResponse) GetFranchises() []*Franchise {
+	if x != nil {
+		return x.Franchises
+	}
+	return nil
+}
+
+type GetFranchiseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFranchiseRequest) Reset() {
+	*x = GetFranchiseRequest{}
+	mi := &file_catalog_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFranchiseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFranchiseRequest) ProtoMessage() {}
+
+func (x *GetFranchiseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFranchiseRequest.ProtoReflect.Descriptor instead.
+func (*GetFranchiseRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetFranchiseRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateFranchiseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFranchiseRequest) Reset() {
+	*x = UpdateFranchiseRequest{}
+	mi := &file_catalog_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFranchiseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFranchiseRequest) ProtoMessage() {}
+
+func (x *UpdateFranchiseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFranchiseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFranchiseRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UpdateFranchiseRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateFranchiseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateFranchiseRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type DeleteFranchiseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFranchiseRequest) Reset() {
+	*x = DeleteFranchiseRequest{}
+	mi := &file_catalog_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFranchiseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFranchiseRequest) ProtoMessage() {}
+
+func (x *DeleteFranchiseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFranchiseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFranchiseRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteFranchiseRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetTagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pending, approved (default) or rejected.
+	Status        string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTagsRequest) Reset() {
+	*x = GetTagsRequest{}
+	mi := &file_catalog_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagsRequest) ProtoMessage() {}
+
+func (x *GetTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagsRequest.ProtoReflect.Descriptor instead.
+func (*GetTagsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetTagsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type TagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagsResponse) Reset() {
+	*x = TagsResponse{}
+	mi := &file_catalog_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagsResponse) ProtoMessage() {}
+
+func (x *TagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagsResponse.ProtoReflect.Descriptor instead.
+func (*TagsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *TagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type SuggestTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestTagRequest) Reset() {
+	*x = SuggestTagRequest{}
+	mi := &file_catalog_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestTagRequest) ProtoMessage() {}
+
+func (x *SuggestTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestTagRequest.ProtoReflect.Descriptor instead.
+func (*SuggestTagRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SuggestTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ModerateTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateTagRequest) Reset() {
+	*x = ModerateTagRequest{}
+	mi := &file_catalog_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateTagRequest) ProtoMessage() {}
+
+func (x *ModerateTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateTagRequest.ProtoReflect.Descriptor instead.
+func (*ModerateTagRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ModerateTagRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_catalog_proto protoreflect.FileDescriptor
+
+const file_catalog_proto_rawDesc = "" +
+	"\n" +
+	"\rcatalog.proto\x12\acatalog\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xe0\f\n" +
+	"\x04Game\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1c\n" +
+	"\tdeveloper\x18\x04 \x01(\tR\tdeveloper\x12\x1c\n" +
+	"\tpublisher\x18\x05 \x01(\tR\tpublisher\x12=\n" +
+	"\frelease_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vreleaseDate\x12&\n" +
+	"\x06genres\x18\a \x03(\v2\x0e.catalog.GenreR\x06genres\x12/\n" +
+	"\tplatforms\x18\b \x03(\v2\x11.catalog.PlatformR\tplatforms\x12\x14\n" +
+	"\x05price\x18\t \x01(\x01R\x05price\x12\x1b\n" +
+	"\timage_url\x18\n" +
+	" \x01(\tR\bimageUrl\x12%\n" +
+	"\x0eaverage_rating\x18\v \x01(\x01R\raverageRating\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\bcurrency\x18\x0e \x01(\tR\bcurrency\x12&\n" +
+	"\x06prices\x18\x0f \x03(\v2\x0e.catalog.PriceR\x06prices\x129\n" +
+	"\n" +
+	"deleted_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1c\n" +
+	"\trelevance\x18\x11 \x01(\x01R\trelevance\x12'\n" +
+	"\x0ftitle_highlight\x18\x12 \x01(\tR\x0etitleHighlight\x123\n" +
+	"\x15description_highlight\x18\x13 \x01(\tR\x14descriptionHighlight\x120\n" +
+	"\n" +
+	"developers\x18\x14 \x03(\v2\x10.catalog.CompanyR\n" +
+	"developers\x120\n" +
+	"\n" +
+	"publishers\x18\x15 \x03(\v2\x10.catalog.CompanyR\n" +
+	"publishers\x12&\n" +
+	"\ffranchise_id\x18\x16 \x01(\rH\x00R\vfranchiseId\x88\x01\x01\x120\n" +
+	"\tfranchise\x18\x17 \x01(\v2\x12.catalog.FranchiseR\tfranchise\x12/\n" +
+	"\arelated\x18\x18 \x01(\v2\x15.catalog.RelatedGamesR\arelated\x12,\n" +
+	"\breleases\x18\x19 \x03(\v2\x10.catalog.ReleaseR\breleases\x123\n" +
+	"\vage_ratings\x18\x1a \x03(\v2\x12.catalog.AgeRatingR\n" +
+	"ageRatings\x12\x16\n" +
+	"\x06locale\x18\x1b \x01(\tR\x06locale\x12<\n" +
+	"\ftranslations\x18\x1c \x03(\v2\x18.catalog.GameTranslationR\ftranslations\x12(\n" +
+	"\x05media\x18\x1d \x03(\v2\x12.catalog.GameMediaR\x05media\x12L\n" +
+	"\x13system_requirements\x18\x1e \x03(\v2\x1b.catalog.SystemRequirementsR\x12systemRequirements\x12$\n" +
+	"\x04tags\x18\x1f \x03(\v2\x10.catalog.GameTagR\x04tags\x123\n" +
+	"\tlanguages\x18  \x03(\v2\x15.catalog.GameLanguageR\tlanguages\x12\x1d\n" +
+	"\n" +
+	"game_modes\x18! \x03(\tR\tgameModes\x12\x1f\n" +
+	"\vmin_players\x18\" \x01(\x05R\n" +
+	"minPlayers\x12\x1f\n" +
+	"\vmax_players\x18# \x01(\x05R\n" +
+	"maxPlayers\x12$\n" +
+	"\raccessibility\x18$ \x03(\tR\raccessibility\x126\n" +
+	"\fexternal_ids\x18% \x03(\v2\x13.catalog.ExternalIDR\vexternalIds\x12\x12\n" +
+	"\x04uuid\x18& \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04slug\x18' \x01(\tR\x04slugB\x0f\n" +
+	"\r_franchise_id\"t\n" +
+	"\x05Price\x12\x1f\n" +
+	"\vplatform_id\x18\x01 \x01(\rR\n" +
+	"platformId\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"\xa8\x01\n" +
+	"\aRelease\x12\x1f\n" +
+	"\vplatform_id\x18\x01 \x01(\rR\n" +
+	"platformId\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12.\n" +
+	"\x04date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x1c\n" +
+	"\tprecision\x18\x04 \x01(\tR\tprecision\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\"|\n" +
+	"\tAgeRating\x12\x14\n" +
+	"\x05board\x18\x01 \x01(\tR\x05board\x12\x16\n" +
+	"\x06rating\x18\x02 \x01(\tR\x06rating\x12\x1f\n" +
+	"\vminimum_age\x18\x03 \x01(\x05R\n" +
+	"minimumAge\x12 \n" +
+	"\vdescriptors\x18\x04 \x03(\tR\vdescriptors\"\xf0\x01\n" +
+	"\x0fGameTranslation\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\rR\x06gameId\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd8\x01\n" +
+	"\tGameMedia\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\rR\x06gameId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12\x18\n" +
+	"\acaption\x18\x05 \x01(\tR\acaption\x12\x14\n" +
+	"\x05width\x18\x06 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\a \x01(\x05R\x06height\x12\x1a\n" +
+	"\bposition\x18\b \x01(\x05R\bposition\x12\x18\n" +
+	"\aprimary\x18\t \x01(\bR\aprimary\"\xe2\x01\n" +
+	"\x12SystemRequirements\x12\x1f\n" +
+	"\vplatform_id\x18\x01 \x01(\rR\n" +
+	"platformId\x12\x12\n" +
+	"\x04tier\x18\x02 \x01(\tR\x04tier\x12\x0e\n" +
+	"\x02os\x18\x03 \x01(\tR\x02os\x12\x10\n" +
+	"\x03cpu\x18\x04 \x01(\tR\x03cpu\x12\x10\n" +
+	"\x03gpu\x18\x05 \x01(\tR\x03gpu\x12\x1b\n" +
+	"\tmemory_mb\x18\x06 \x01(\x05R\bmemoryMb\x12\x1d\n" +
+	"\n" +
+	"storage_mb\x18\a \x01(\x05R\tstorageMb\x12'\n" +
+	"\x0fdirectx_version\x18\b \x01(\tR\x0edirectxVersion\"v\n" +
+	"\x03Tag\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"game_count\x18\x04 \x01(\x03R\tgameCount\x12\x14\n" +
+	"\x05votes\x18\x05 \x01(\x03R\x05votes\"V\n" +
+	"\aGameTag\x12\x15\n" +
+	"\x06tag_id\x18\x01 \x01(\rR\x05tagId\x12\x14\n" +
+	"\x05votes\x18\x02 \x01(\x05R\x05votes\x12\x1e\n" +
+	"\x03tag\x18\x03 \x01(\v2\f.catalog.TagR\x03tag\"|\n" +
+	"\fGameLanguage\x12\x1a\n" +
+	"\blanguage\x18\x01 \x01(\tR\blanguage\x12\x1c\n" +
+	"\tinterface\x18\x02 \x01(\bR\tinterface\x12\x14\n" +
+	"\x05audio\x18\x03 \x01(\bR\x05audio\x12\x1c\n" +
+	"\tsubtitles\x18\x04 \x01(\bR\tsubtitles\"I\n" +
+	"\n" +
+	"ExternalID\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x1f\n" +
+	"\vexternal_id\x18\x02 \x01(\tR\n" +
+	"externalId\"+\n" +
+	"\x05Genre\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\".\n" +
+	"\bPlatform\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"Q\n" +
+	"\tFranchise\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"\xc8\x01\n" +
+	"\fGameRelation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\rR\x06gameId\x12&\n" +
+	"\x0frelated_game_id\x18\x03 \x01(\rR\rrelatedGameId\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12!\n" +
+	"\x04game\x18\x05 \x01(\v2\r.catalog.GameR\x04game\x120\n" +
+	"\frelated_game\x18\x06 \x01(\v2\r.catalog.GameR\vrelatedGame\"\xae\x01\n" +
+	"\fRelatedGames\x12%\n" +
+	"\x06series\x18\x01 \x03(\v2\r.catalog.GameR\x06series\x123\n" +
+	"\trelations\x18\x02 \x03(\v2\x15.catalog.GameRelationR\trelations\x12B\n" +
+	"\x11inverse_relations\x18\x03 \x03(\v2\x15.catalog.GameRelationR\x10inverseRelations\"G\n" +
+	"\aCompany\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\awebsite\x18\x03 \x01(\tR\awebsite\"\xcc\a\n" +
+	"\x11CreateGameRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1c\n" +
+	"\tdeveloper\x18\x03 \x01(\tR\tdeveloper\x12\x1c\n" +
+	"\tpublisher\x18\x04 \x01(\tR\tpublisher\x12=\n" +
+	"\frelease_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vreleaseDate\x12\x1b\n" +
+	"\tgenre_ids\x18\x06 \x03(\rR\bgenreIds\x12!\n" +
+	"\fplatform_ids\x18\a \x03(\rR\vplatformIds\x12\x14\n" +
+	"\x05price\x18\b \x01(\x01R\x05price\x12\x1b\n" +
+	"\timage_url\x18\t \x01(\tR\bimageUrl\x12\x1a\n" +
+	"\bcurrency\x18\n" +
+	" \x01(\tR\bcurrency\x12&\n" +
+	"\x06prices\x18\v \x03(\v2\x0e.catalog.PriceR\x06prices\x12#\n" +
+	"\rdeveloper_ids\x18\f \x03(\rR\fdeveloperIds\x12#\n" +
+	"\rpublisher_ids\x18\r \x03(\rR\fpublisherIds\x12&\n" +
+	"\ffranchise_id\x18\x0e \x01(\rH\x00R\vfranchiseId\x88\x01\x01\x12,\n" +
+	"\breleases\x18\x0f \x03(\v2\x10.catalog.ReleaseR\breleases\x123\n" +
+	"\vage_ratings\x18\x10 \x03(\v2\x12.catalog.AgeRatingR\n" +
+	"ageRatings\x12L\n" +
+	"\x13system_requirements\x18\x11 \x03(\v2\x1b.catalog.SystemRequirementsR\x12systemRequirements\x123\n" +
+	"\tlanguages\x18\x12 \x03(\v2\x15.catalog.GameLanguageR\tlanguages\x12\x1d\n" +
+	"\n" +
+	"game_modes\x18\x13 \x03(\tR\tgameModes\x12\x1f\n" +
+	"\vmin_players\x18\x14 \x01(\x05R\n" +
+	"minPlayers\x12\x1f\n" +
+	"\vmax_players\x18\x15 \x01(\x05R\n" +
+	"maxPlayers\x12$\n" +
+	"\raccessibility\x18\x16 \x03(\tR\raccessibility\x126\n" +
+	"\fexternal_ids\x18\x17 \x03(\v2\x13.catalog.ExternalIDR\vexternalIds\x12%\n" +
+	"\x0eaverage_rating\x18\x18 \x01(\x01R\raverageRatingB\x0f\n" +
+	"\r_franchise_id\"j\n" +
+	"\x0eGetGameRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x10\n" +
+	"\x03ref\x18\x04 \x01(\tR\x03ref\x12\x18\n" +
+	"\ainclude\x18\x02 \x03(\tR\ainclude\x12\x1c\n" +
+	"\tlanguages\x18\x03 \x03(\tR\tlanguages\"w\n" +
+	"\x1aGetGameByExternalIDRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x1f\n" +
+	"\vexternal_id\x18\x02 \x01(\tR\n" +
+	"externalId\x12\x1c\n" +
+	"\tlanguages\x18\x03 \x03(\tR\tlanguages\"\xdc\a\n" +
+	"\x11UpdateGameRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1c\n" +
+	"\tdeveloper\x18\x04 \x01(\tR\tdeveloper\x12\x1c\n" +
+	"\tpublisher\x18\x05 \x01(\tR\tpublisher\x12=\n" +
+	"\frelease_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vreleaseDate\x12\x1b\n" +
+	"\tgenre_ids\x18\a \x03(\rR\bgenreIds\x12!\n" +
+	"\fplatform_ids\x18\b \x03(\rR\vplatformIds\x12\x14\n" +
+	"\x05price\x18\t \x01(\x01R\x05price\x12\x1b\n" +
+	"\timage_url\x18\n" +
+	" \x01(\tR\bimageUrl\x12\x1a\n" +
+	"\bcurrency\x18\v \x01(\tR\bcurrency\x12&\n" +
+	"\x06prices\x18\f \x03(\v2\x0e.catalog.PriceR\x06prices\x12#\n" +
+	"\rdeveloper_ids\x18\r \x03(\rR\fdeveloperIds\x12#\n" +
+	"\rpublisher_ids\x18\x0e \x03(\rR\fpublisherIds\x12&\n" +
+	"\ffranchise_id\x18\x0f \x01(\rH\x00R\vfranchiseId\x88\x01\x01\x12,\n" +
+	"\breleases\x18\x10 \x03(\v2\x10.catalog.ReleaseR\breleases\x123\n" +
+	"\vage_ratings\x18\x11 \x03(\v2\x12.catalog.AgeRatingR\n" +
+	"ageRatings\x12L\n" +
+	"\x13system_requirements\x18\x12 \x03(\v2\x1b.catalog.SystemRequirementsR\x12systemRequirements\x123\n" +
+	"\tlanguages\x18\x13 \x03(\v2\x15.catalog.GameLanguageR\tlanguages\x12\x1d\n" +
+	"\n" +
+	"game_modes\x18\x14 \x03(\tR\tgameModes\x12\x1f\n" +
+	"\vmin_players\x18\x15 \x01(\x05R\n" +
+	"minPlayers\x12\x1f\n" +
+	"\vmax_players\x18\x16 \x01(\x05R\n" +
+	"maxPlayers\x12$\n" +
+	"\raccessibility\x18\x17 \x03(\tR\raccessibility\x126\n" +
+	"\fexternal_ids\x18\x18 \x03(\v2\x13.catalog.ExternalIDR\vexternalIds\x12%\n" +
+	"\x0eaverage_rating\x18\x19 \x01(\x01R\raverageRatingB\x0f\n" +
+	"\r_franchise_id\"6\n" +
+	"\x1bListGameTranslationsRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\rR\x06gameId\"X\n" +
+	"\x18GameTranslationsResponse\x12<\n" +
+	"\ftranslations\x18\x01 \x03(\v2\x18.catalog.GameTranslationR\ftranslations\"\x84\x01\n" +
+	"\x19SetGameTranslationRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\rR\x06gameId\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"O\n" +
+	"\x1cDeleteGameTranslationRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\rR\x06gameId\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\"/\n" +
+	"\x14ListGameMediaRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\rR\x06gameId\"=\n" +
+	"\x11GameMediaResponse\x12(\n" +
+	"\x05media\x18\x01 \x03(\v2\x12.catalog.GameMediaR\x05media\"\xb6\x01\n" +
+	"\x13AddGameMediaRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\rR\x06gameId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x18\n" +
+	"\acaption\x18\x04 \x01(\tR\acaption\x12\x14\n" +
+	"\x05width\x18\x05 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x06 \x01(\x05R\x06height\x12\x18\n" +
+	"\aprimary\x18\a \x01(\bR\aprimary\"O\n" +
+	"\x17ReorderGameMediaRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\rR\x06gameId\x12\x1b\n" +
+	"\tmedia_ids\x18\x02 \x03(\rR\bmediaIds\"L\n" +
+	"\x16RemoveGameMediaRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\rR\x06gameId\x12\x19\n" +
+	"\bmedia_id\x18\x02 \x01(\rR\amediaId\"\\\n" +
+	"\x12VoteGameTagRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\rR\x06gameId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bvoter_id\x18\x03 \x01(\tR\avoterId\"e\n" +
+	"\x18RemoveGameTagVoteRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\rR\x06gameId\x12\x15\n" +
+	"\x06tag_id\x18\x02 \x01(\rR\x05tagId\x12\x19\n" +
+	"\bvoter_id\x18\x03 \x01(\tR\avoterId\"#\n" +
+	"\x11DeleteGameRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"$\n" +
+	"\x12RestoreGameRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"F\n" +
+	"\x10GameGenreRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\rR\x06gameId\x12\x19\n" +
+	"\bgenre_id\x18\x02 \x01(\rR\agenreId\"O\n" +
+	"\x13GamePlatformRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\rR\x06gameId\x12\x1f\n" +
+	"\vplatform_id\x18\x02 \x01(\rR\n" +
+	"platformId\"m\n" +
+	"\x16AddGameRelationRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\rR\x06gameId\x12&\n" +
+	"\x0frelated_game_id\x18\x02 \x01(\rR\rrelatedGameId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\"U\n" +
+	"\x19RemoveGameRelationRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\rR\x06gameId\x12\x1f\n" +
+	"\vrelation_id\x18\x02 \x01(\rR\n" +
+	"relationId\"\xeb\x0e\n" +
+	"\x10ListGamesRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1c\n" +
+	"\tdeveloper\x18\x02 \x01(\tR\tdeveloper\x12\x1c\n" +
+	"\tpublisher\x18\x03 \x01(\tR\tpublisher\x12\x16\n" +
+	"\x06genres\x18\x04 \x03(\tR\x06genres\x12\x1c\n" +
+	"\tplatforms\x18\x05 \x03(\tR\tplatforms\x12 \n" +
+	"\tmin_price\x18\x06 \x01(\x01H\x00R\bminPrice\x88\x01\x01\x12 \n" +
+	"\tmax_price\x18\a \x01(\x01H\x01R\bmaxPrice\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"min_rating\x18\b \x01(\x01H\x02R\tminRating\x88\x01\x01\x12\x17\n" +
+	"\asort_by\x18\t \x01(\tR\x06sortBy\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\n" +
+	" \x01(\tR\tsortOrder\x12\x12\n" +
+	"\x04page\x18\v \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\f \x01(\x05R\bpageSize\x12\x1a\n" +
+	"\bcurrency\x18\r \x01(\tR\bcurrency\x12'\n" +
+	"\x0finclude_deleted\x18\x0e \x01(\bR\x0eincludeDeleted\x12\f\n" +
+	"\x01q\x18\x0f \x01(\tR\x01q\x12\x14\n" +
+	"\x05fuzzy\x18\x10 \x01(\bR\x05fuzzy\x12\x16\n" +
+	"\x06cursor\x18\x11 \x01(\tR\x06cursor\x12\x16\n" +
+	"\x06facets\x18\x12 \x01(\bR\x06facets\x12\x1b\n" +
+	"\tgenre_ids\x18\x13 \x03(\rR\bgenreIds\x12!\n" +
+	"\fplatform_ids\x18\x14 \x03(\rR\vplatformIds\x12\x1f\n" +
+	"\vgenre_match\x18\x15 \x01(\tR\n" +
+	"genreMatch\x12%\n" +
+	"\x0eplatform_match\x18\x16 \x01(\tR\rplatformMatch\x12%\n" +
+	"\x0eexclude_genres\x18\x17 \x03(\tR\rexcludeGenres\x12+\n" +
+	"\x11exclude_platforms\x18\x18 \x03(\tR\x10excludePlatforms\x12#\n" +
+	"\rdeveloper_ids\x18\x19 \x03(\rR\fdeveloperIds\x12#\n" +
+	"\rpublisher_ids\x18\x1a \x03(\rR\fpublisherIds\x12\x1f\n" +
+	"\vcompany_ids\x18\x1b \x03(\rR\n" +
+	"companyIds\x123\n" +
+	"\x13release_platform_id\x18\x1c \x01(\rH\x03R\x11releasePlatformId\x88\x01\x01\x12%\n" +
+	"\x0erelease_region\x18\x1d \x01(\tR\rreleaseRegion\x12A\n" +
+	"\x0ereleased_after\x18\x1e \x01(\v2\x1a.google.protobuf.TimestampR\rreleasedAfter\x12C\n" +
+	"\x0freleased_before\x18\x1f \x01(\v2\x1a.google.protobuf.TimestampR\x0ereleasedBefore\x12%\n" +
+	"\x0erelease_status\x18  \x01(\tR\rreleaseStatus\x12\x1a\n" +
+	"\bupcoming\x18! \x01(\bR\bupcoming\x12\x1c\n" +
+	"\amax_age\x18\" \x01(\x05H\x04R\x06maxAge\x88\x01\x01\x12!\n" +
+	"\frating_board\x18# \x01(\tR\vratingBoard\x12\x1c\n" +
+	"\tlanguages\x18$ \x03(\tR\tlanguages\x12\x18\n" +
+	"\ainclude\x18% \x03(\tR\ainclude\x12'\n" +
+	"\rmax_memory_gb\x18& \x01(\x05H\x05R\vmaxMemoryGb\x88\x01\x01\x12)\n" +
+	"\x0emax_storage_gb\x18' \x01(\x05H\x06R\fmaxStorageGb\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18( \x03(\tR\x04tags\x12\x1b\n" +
+	"\ttag_match\x18) \x01(\tR\btagMatch\x12/\n" +
+	"\x13interface_languages\x18* \x03(\tR\x12interfaceLanguages\x12'\n" +
+	"\x0faudio_languages\x18+ \x03(\tR\x0eaudioLanguages\x12-\n" +
+	"\x12subtitle_languages\x18, \x03(\tR\x11subtitleLanguages\x12\x1d\n" +
+	"\n" +
+	"game_modes\x18- \x03(\tR\tgameModes\x12\x1d\n" +
+	"\aplayers\x18. \x01(\x05H\aR\aplayers\x88\x01\x01\x12$\n" +
+	"\raccessibility\x18/ \x03(\tR\raccessibility\x12.\n" +
+	"\x13missing_external_id\x180 \x01(\tR\x11missingExternalId\x12*\n" +
+	"\x11exclude_genre_ids\x181 \x03(\rR\x0fexcludeGenreIds\x120\n" +
+	"\x14exclude_platform_ids\x182 \x03(\rR\x12excludePlatformIdsB\f\n" +
+	"\n" +
+	"_min_priceB\f\n" +
+	"\n" +
+	"_max_priceB\r\n" +
+	"\v_min_ratingB\x16\n" +
+	"\x14_release_platform_idB\n" +
+	"\n" +
+	"\b_max_ageB\x10\n" +
+	"\x0e_max_memory_gbB\x11\n" +
+	"\x0f_max_storage_gbB\n" +
+	"\n" +
+	"\b_players\"\xf9\x01\n" +
+	"\x11ListGamesResponse\x12#\n" +
+	"\x05games\x18\x01 \x03(\v2\r.catalog.GameR\x05games\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x03R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
+	"totalPages\x12\x1f\n" +
+	"\vnext_cursor\x18\x06 \x01(\tR\n" +
+	"nextCursor\x12+\n" +
+	"\x06facets\x18\a \x01(\v2\x13.catalog.GameFacetsR\x06facets\"H\n" +
+	"\n" +
+	"FacetCount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"\xae\x02\n" +
+	"\n" +
+	"GameFacets\x12+\n" +
+	"\x06genres\x18\x01 \x03(\v2\x13.catalog.FacetCountR\x06genres\x121\n" +
+	"\tplatforms\x18\x02 \x03(\v2\x13.catalog.FacetCountR\tplatforms\x123\n" +
+	"\n" +
+	"developers\x18\x03 \x03(\v2\x13.catalog.FacetCountR\n" +
+	"developers\x123\n" +
+	"\n" +
+	"publishers\x18\x04 \x03(\v2\x13.catalog.FacetCountR\n" +
+	"publishers\x12-\n" +
+	"\aratings\x18\x05 \x03(\v2\x13.catalog.FacetCountR\aratings\x12'\n" +
+	"\x04tags\x18\x06 \x03(\v2\x13.catalog.FacetCountR\x04tags\"I\n" +
+	"\x19AutocompleteTitlesRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"W\n" +
+	"\x0fTitleSuggestion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x03 \x01(\x01R\n" +
+	"similarity\"X\n" +
+	"\x1aAutocompleteTitlesResponse\x12:\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x18.catalog.TitleSuggestionR\vsuggestions\"(\n" +
+	"\x12CreateGenreRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"8\n" +
+	"\x0eGenresResponse\x12&\n" +
+	"\x06genres\x18\x01 \x03(\v2\x0e.catalog.GenreR\x06genres\"!\n" +
+	"\x0fGetGenreRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"8\n" +
+	"\x12UpdateGenreRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\">\n" +
+	"\x12DeleteGenreRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x18\n" +
+	"\acascade\x18\x02 \x01(\bR\acascade\"N\n" +
+	"\x12MergeGenresRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\rR\bsourceId\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\rR\btargetId\"+\n" +
+	"\x15CreatePlatformRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"D\n" +
+	"\x11PlatformsResponse\x12/\n" +
+	"\tplatforms\x18\x01 \x03(\v2\x11.catalog.PlatformR\tplatforms\"$\n" +
+	"\x12GetPlatformRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\";\n" +
+	"\x15UpdatePlatformRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"A\n" +
+	"\x15DeletePlatformRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x18\n" +
+	"\acascade\x18\x02 \x01(\bR\acascade\"Q\n" +
+	"\x15MergePlatformsRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\rR\bsourceId\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\rR\btargetId\"D\n" +
+	"\x14CreateCompanyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\awebsite\x18\x02 \x01(\tR\awebsite\"C\n" +
+	"\x11CompaniesResponse\x12.\n" +
+	"\tcompanies\x18\x01 \x03(\v2\x10.catalog.CompanyR\tcompanies\"#\n" +
+	"\x11GetCompanyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"T\n" +
+	"\x14UpdateCompanyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\awebsite\x18\x03 \x01(\tR\awebsite\"@\n" +
+	"\x14DeleteCompanyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x18\n" +
+	"\acascade\x18\x02 \x01(\bR\acascade\"N\n" +
+	"\x16CreateFranchiseRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"H\n" +
+	"\x12FranchisesResponse\x122\n" +
+	"\n" +
+	"franchises\x18\x01 \x03(\v2\x12.catalog.FranchiseR\n" +
+	"franchises\"%\n" +
+	"\x13GetFranchiseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"^\n" +
+	"\x16UpdateFranchiseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"(\n" +
+	"\x16DeleteFranchiseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"(\n" +
+	"\x0eGetTagsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"0\n" +
+	"\fTagsResponse\x12 \n" +
+	"\x04tags\x18\x01 \x03(\v2\f.catalog.TagR\x04tags\"'\n" +
+	"\x11SuggestTagRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"$\n" +
+	"\x12ModerateTagRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id2\xa6\x1a\n" +
+	"\x0eCatalogService\x127\n" +
+	"\n" +
+	"CreateGame\x12\x1a.catalog.CreateGameRequest\x1a\r.catalog.Game\x121\n" +
+	"\aGetGame\x12\x17.catalog.GetGameRequest\x1a\r.catalog.Game\x12I\n" +
+	"\x13GetGameByExternalID\x12#.catalog.GetGameByExternalIDRequest\x1a\r.catalog.Game\x127\n" +
+	"\n" +
+	"UpdateGame\x12\x1a.catalog.UpdateGameRequest\x1a\r.catalog.Game\x12@\n" +
+	"\n" +
+	"DeleteGame\x12\x1a.catalog.DeleteGameRequest\x1a\x16.google.protobuf.Empty\x129\n" +
+	"\vRestoreGame\x12\x1b.catalog.RestoreGameRequest\x1a\r.catalog.Game\x12B\n" +
+	"\tListGames\x12\x19.catalog.ListGamesRequest\x1a\x1a.catalog.ListGamesResponse\x12]\n" +
+	"\x12AutocompleteTitles\x12\".catalog.AutocompleteTitlesRequest\x1a#.catalog.AutocompleteTitlesResponse\x128\n" +
+	"\fAddGameGenre\x12\x19.catalog.GameGenreRequest\x1a\r.catalog.Game\x12;\n" +
+	"\x0fRemoveGameGenre\x12\x19.catalog.GameGenreRequest\x1a\r.catalog.Game\x12>\n" +
+	"\x0fAddGamePlatform\x12\x1c.catalog.GamePlatformRequest\x1a\r.catalog.Game\x12A\n" +
+	"\x12RemoveGamePlatform\x12\x1c.catalog.GamePlatformRequest\x1a\r.catalog.Game\x12I\n" +
+	"\x0fAddGameRelation\x12\x1f.catalog.AddGameRelationRequest\x1a\x15.catalog.GameRelation\x12P\n" +
+	"\x12RemoveGameRelation\x12\".catalog.RemoveGameRelationRequest\x1a\x16.google.protobuf.Empty\x12_\n" +
+	"\x14ListGameTranslations\x12$.catalog.ListGameTranslationsRequest\x1a!.catalog.GameTranslationsResponse\x12R\n" +
+	"\x12SetGameTranslation\x12\".catalog.SetGameTranslationRequest\x1a\x18.catalog.GameTranslation\x12V\n" +
+	"\x15DeleteGameTranslation\x12%.catalog.DeleteGameTranslationRequest\x1a\x16.google.protobuf.Empty\x12J\n" +
+	"\rListGameMedia\x12\x1d.catalog.ListGameMediaRequest\x1a\x1a.catalog.GameMediaResponse\x12@\n" +
+	"\fAddGameMedia\x12\x1c.catalog.AddGameMediaRequest\x1a\x12.catalog.GameMedia\x12P\n" +
+	"\x10ReorderGameMedia\x12 .catalog.ReorderGameMediaRequest\x1a\x1a.catalog.GameMediaResponse\x12J\n" +
+	"\x0fRemoveGameMedia\x12\x1f.catalog.RemoveGameMediaRequest\x1a\x16.google.protobuf.Empty\x12<\n" +
+	"\vVoteGameTag\x12\x1b.catalog.VoteGameTagRequest\x1a\x10.catalog.GameTag\x12N\n" +
+	"\x11RemoveGameTagVote\x12!.catalog.RemoveGameTagVoteRequest\x1a\x16.google.protobuf.Empty\x12:\n" +
+	"\vCreateGenre\x12\x1b.catalog.CreateGenreRequest\x1a\x0e.catalog.Genre\x12?\n" +
+	"\fGetAllGenres\x12\x16.google.protobuf.Empty\x1a\x17.catalog.GenresResponse\x124\n" +
+	"\bGetGenre\x12\x18.catalog.GetGenreRequest\x1a\x0e.catalog.Genre\x12:\n" +
+	"\vUpdateGenre\x12\x1b.catalog.UpdateGenreRequest\x1a\x0e.catalog.Genre\x12B\n" +
+	"\vDeleteGenre\x12\x1b.catalog.DeleteGenreRequest\x1a\x16.google.protobuf.Empty\x12:\n" +
+	"\vMergeGenres\x12\x1b.catalog.MergeGenresRequest\x1a\x0e.catalog.Genre\x12C\n" +
+	"\x0eCreatePlatform\x12\x1e.catalog.CreatePlatformRequest\x1a\x11.catalog.Platform\x12E\n" +
+	"\x0fGetAllPlatforms\x12\x16.google.protobuf.Empty\x1a\x1a.catalog.PlatformsResponse\x12=\n" +
+	"\vGetPlatform\x12\x1b.catalog.GetPlatformRequest\x1a\x11.catalog.Platform\x12C\n" +
+	"\x0eUpdatePlatform\x12\x1e.catalog.UpdatePlatformRequest\x1a\x11.catalog.Platform\x12H\n" +
+	"\x0eDeletePlatform\x12\x1e.catalog.DeletePlatformRequest\x1a\x16.google.protobuf.Empty\x12C\n" +
+	"\x0eMergePlatforms\x12\x1e.catalog.MergePlatformsRequest\x1a\x11.catalog.Platform\x12@\n" +
+	"\rCreateCompany\x12\x1d.catalog.CreateCompanyRequest\x1a\x10.catalog.Company\x12E\n" +
+	"\x0fGetAllCompanies\x12\x16.google.protobuf.Empty\x1a\x1a.catalog.CompaniesResponse\x12:\n" +
+	"\n" +
+	"GetCompany\x12\x1a.catalog.GetCompanyRequest\x1a\x10.catalog.Company\x12@\n" +
+	"\rUpdateCompany\x12\x1d.catalog.UpdateCompanyRequest\x1a\x10.catalog.Company\x12F\n" +
+	"\rDeleteCompany\x12\x1d.catalog.DeleteCompanyRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\x0fCreateFranchise\x12\x1f.catalog.CreateFranchiseRequest\x1a\x12.catalog.Franchise\x12G\n" +
+	"\x10GetAllFranchises\x12\x16.google.protobuf.Empty\x1a\x1b.catalog.FranchisesResponse\x12@\n" +
+	"\fGetFranchise\x12\x1c.catalog.GetFranchiseRequest\x1a\x12.catalog.Franchise\x12F\n" +
+	"\x0fUpdateFranchise\x12\x1f.catalog.UpdateFranchiseRequest\x1a\x12.catalog.Franchise\x12J\n" +
+	"\x0fDeleteFranchise\x12\x1f.catalog.DeleteFranchiseRequest\x1a\x16.google.protobuf.Empty\x129\n" +
+	"\aGetTags\x12\x17.catalog.GetTagsRequest\x1a\x15.catalog.TagsResponse\x126\n" +
+	"\n" +
+	"SuggestTag\x12\x1a.catalog.SuggestTagRequest\x1a\f.catalog.Tag\x127\n" +
+	"\n" +
+	"ApproveTag\x12\x1b.catalog.ModerateTagRequest\x1a\f.catalog.Tag\x126\n" +
+	"\tRejectTag\x12\x1b.catalog.ModerateTagRequest\x1a\f.catalog.TagB;Z9github.com/NNNACHID/api-game-catalog-cl/api/proto/catalogb\x06proto3"
+
+var (
+	file_catalog_proto_rawDescOnce sync.Once
+	file_catalog_proto_rawDescData []byte
+)
+
+func file_catalog_proto_rawDescGZIP() []byte {
+	file_catalog_proto_rawDescOnce.Do(func() {
+		file_catalog_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_catalog_proto_rawDesc), len(file_catalog_proto_rawDesc)))
+	})
+	return file_catalog_proto_rawDescData
+}
+
+var file_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 71)
+var file_catalog_proto_goTypes = []any{
+	(*Game)(nil),                         // 0: catalog.Game
+	(*Price)(nil),                        // 1: catalog.Price
+	(*Release)(nil),                      // 2: catalog.Release
+	(*AgeRating)(nil),                    // 3: catalog.AgeRating
+	(*GameTranslation)(nil),              // 4: catalog.GameTranslation
+	(*GameMedia)(nil),                    // 5: catalog.GameMedia
+	(*SystemRequirements)(nil),           // 6: catalog.SystemRequirements
+	(*Tag)(nil),                          // 7: catalog.Tag
+	(*GameTag)(nil),                      // 8: catalog.GameTag
+	(*GameLanguage)(nil),                 // 9: catalog.GameLanguage
+	(*ExternalID)(nil),                   // 10: catalog.ExternalID
+	(*Genre)(nil),                        // 11: catalog.Genre
+	(*Platform)(nil),                     // 12: catalog.Platform
+	(*Franchise)(nil),                    // 13: catalog.Franchise
+	(*GameRelation)(nil),                 // 14: catalog.GameRelation
+	(*RelatedGames)(nil),                 // 15: catalog.RelatedGames
+	(*Company)(nil),                      // 16: catalog.Company
+	(*CreateGameRequest)(nil),            // 17: catalog.CreateGameRequest
+	(*GetGameRequest)(nil),               // 18: catalog.GetGameRequest
+	(*GetGameByExternalIDRequest)(nil),   // 19: catalog.GetGameByExternalIDRequest
+	(*UpdateGameRequest)(nil),            // 20: catalog.UpdateGameRequest
+	(*ListGameTranslationsRequest)(nil),  // 21: catalog.ListGameTranslationsRequest
+	(*GameTranslationsResponse)(nil),     // 22: catalog.GameTranslationsResponse
+	(*SetGameTranslationRequest)(nil),    // 23: catalog.SetGameTranslationRequest
+	(*DeleteGameTranslationRequest)(nil), // 24: catalog.DeleteGameTranslationRequest
+	(*ListGameMediaRequest)(nil),         // 25: catalog.ListGameMediaRequest
+	(*GameMediaResponse)(nil),            // 26: catalog.GameMediaResponse
+	(*AddGameMediaRequest)(nil),          // 27: catalog.AddGameMediaRequest
+	(*ReorderGameMediaRequest)(nil),      // 28: catalog.ReorderGameMediaRequest
+	(*RemoveGameMediaRequest)(nil),       // 29: catalog.RemoveGameMediaRequest
+	(*VoteGameTagRequest)(nil),           // 30: catalog.VoteGameTagRequest
+	(*RemoveGameTagVoteRequest)(nil),     // 31: catalog.RemoveGameTagVoteRequest
+	(*DeleteGameRequest)(nil),            // 32: catalog.DeleteGameRequest
+	(*RestoreGameRequest)(nil),           // 33: catalog.RestoreGameRequest
+	(*GameGenreRequest)(nil),             // 34: catalog.GameGenreRequest
+	(*GamePlatformRequest)(nil),          // 35: catalog.GamePlatformRequest
+	(*AddGameRelationRequest)(nil),       // 36: catalog.AddGameRelationRequest
+	(*RemoveGameRelationRequest)(nil),    // 37: catalog.RemoveGameRelationRequest
+	(*ListGamesRequest)(nil),             // 38: catalog.ListGamesRequest
+	(*ListGamesResponse)(nil),            // 39: catalog.ListGamesResponse
+	(*FacetCount)(nil),                   // 40: catalog.FacetCount
+	(*GameFacets)(nil),                   // 41: catalog.GameFacets
+	(*AutocompleteTitlesRequest)(nil),    // 42: catalog.AutocompleteTitlesRequest
+	(*TitleSuggestion)(nil),              // 43: catalog.TitleSuggestion
+	(*AutocompleteTitlesResponse)(nil),   // 44: catalog.AutocompleteTitlesResponse
+	(*CreateGenreRequest)(nil),           // 45: catalog.CreateGenreRequest
+	(*GenresResponse)(nil),               // 46: catalog.GenresResponse
+	(*GetGenreRequest)(nil),              // 47: catalog.GetGenreRequest
+	(*UpdateGenreRequest)(nil),           // 48: catalog.UpdateGenreRequest
+	(*DeleteGenreRequest)(nil),           // 49: catalog.DeleteGenreRequest
+	(*MergeGenresRequest)(nil),           // 50: catalog.MergeGenresRequest
+	(*CreatePlatformRequest)(nil),        // 51: catalog.CreatePlatformRequest
+	(*PlatformsResponse)(nil),            // 52: catalog.PlatformsResponse
+	(*GetPlatformRequest)(nil),           // 53: catalog.GetPlatformRequest
+	(*UpdatePlatformRequest)(nil),        // 54: catalog.UpdatePlatformRequest
+	(*DeletePlatformRequest)(nil),        // 55: catalog.DeletePlatformRequest
+	(*MergePlatformsRequest)(nil),        // 56: catalog.MergePlatformsRequest
+	(*CreateCompanyRequest)(nil),         // 57: catalog.CreateCompanyRequest
+	(*CompaniesResponse)(nil),            // 58: catalog.CompaniesResponse
+	(*GetCompanyRequest)(nil),            // 59: catalog.GetCompanyRequest
+	(*UpdateCompanyRequest)(nil),         // 60: catalog.UpdateCompanyRequest
+	(*DeleteCompanyRequest)(nil),         // 61: catalog.DeleteCompanyRequest
+	(*CreateFranchiseRequest)(nil),       // 62: catalog.CreateFranchiseRequest
+	(*FranchisesResponse)(nil),           // 63: catalog.FranchisesResponse
+	(*GetFranchiseRequest)(nil),          // 64: catalog.GetFranchiseRequest
+	(*UpdateFranchiseRequest)(nil),       // 65: catalog.UpdateFranchiseRequest
+	(*DeleteFranchiseRequest)(nil),       // 66: catalog.DeleteFranchiseRequest
+	(*GetTagsRequest)(nil),               // 67: catalog.GetTagsRequest
+	(*TagsResponse)(nil),                 // 68: catalog.TagsResponse
+	(*SuggestTagRequest)(nil),            // 69: catalog.SuggestTagRequest
+	(*ModerateTagRequest)(nil),           // 70: catalog.ModerateTagRequest
+	(*timestamppb.Timestamp)(nil),        // 71: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                // 72: google.protobuf.Empty
+}
+var file_catalog_proto_depIdxs = []int32{
+	71,  // 0: catalog.Game.release_date:type_name -> google.protobuf.Timestamp
+	11,  // 1: catalog.Game.genres:type_name -> catalog.Genre
+	12,  // 2: catalog.Game.platforms:type_name -> catalog.Platform
+	71,  // 3: catalog.Game.created_at:type_name -> google.protobuf.Timestamp
+	71,  // 4: catalog.Game.updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 5: catalog.Game.prices:type_name -> catalog.Price
+	71,  // 6: catalog.Game.deleted_at:type_name -> google.protobuf.Timestamp
+	16,  // 7: catalog.Game.developers:type_name -> catalog.Company
+	16,  // 8: catalog.Game.publishers:type_name -> catalog.Company
+	13,  // 9: catalog.Game.franchise:type_name -> catalog.Franchise
+	15,  // 10: catalog.Game.related:type_name -> catalog.RelatedGames
+	2,   // 11: catalog.Game.releases:type_name -> catalog.Release
+	3,   // 12: catalog.Game.age_ratings:type_name -> catalog.AgeRating
+	4,   // 13: catalog.Game.translations:type_name -> catalog.GameTranslation
+	5,   // 14: catalog.Game.media:type_name -> catalog.GameMedia
+	6,   // 15: catalog.Game.system_requirements:type_name -> catalog.SystemRequirements
+	8,   // 16: catalog.Game.tags:type_name -> catalog.GameTag
+	9,   // 17: catalog.Game.languages:type_name -> catalog.GameLanguage
+	10,  // 18: catalog.Game.external_ids:type_name -> catalog.ExternalID
+	71,  // 19: catalog.Release.date:type_name -> google.protobuf.Timestamp
+	71,  // 20: catalog.GameTranslation.created_at:type_name -> google.protobuf.Timestamp
+	71,  // 21: catalog.GameTranslation.updated_at:type_name -> google.protobuf.Timestamp
+	7,   // 22: catalog.GameTag.tag:type_name -> catalog.Tag
+	0,   // 23: catalog.GameRelation.game:type_name -> catalog.Game
+	0,   // 24: catalog.GameRelation.related_game:type_name -> catalog.Game
+	0,   // 25: catalog.RelatedGames.series:type_name -> catalog.Game
+	14,  // 26: catalog.RelatedGames.relations:type_name -> catalog.GameRelation
+	14,  // 27: catalog.RelatedGames.inverse_relations:type_name -> catalog.GameRelation
+	71,  // 28: catalog.CreateGameRequest.release_date:type_name -> google.protobuf.Timestamp
+	1,   // 29: catalog.CreateGameRequest.prices:type_name -> catalog.Price
+	2,   // 30: catalog.CreateGameRequest.releases:type_name -> catalog.Release
+	3,   // 31: catalog.CreateGameRequest.age_ratings:type_name -> catalog.AgeRating
+	6,   // 32: catalog.CreateGameRequest.system_requirements:type_name -> catalog.SystemRequirements
+	9,   // 33: catalog.CreateGameRequest.languages:type_name -> catalog.GameLanguage
+	10,  // 34: catalog.CreateGameRequest.external_ids:type_name -> catalog.ExternalID
+	71,  // 35: catalog.UpdateGameRequest.release_date:type_name -> google.protobuf.Timestamp
+	1,   // 36: catalog.UpdateGameRequest.prices:type_name -> catalog.Price
+	2,   // 37: catalog.UpdateGameRequest.releases:type_name -> catalog.Release
+	3,   // 38: catalog.UpdateGameRequest.age_ratings:type_name -> catalog.AgeRating
+	6,   // 39: catalog.UpdateGameRequest.system_requirements:type_name -> catalog.SystemRequirements
+	9,   // 40: catalog.UpdateGameRequest.languages:type_name -> catalog.GameLanguage
+	10,  // 41: catalog.UpdateGameRequest.external_ids:type_name -> catalog.ExternalID
+	4,   // 42: catalog.GameTranslationsResponse.translations:type_name -> catalog.GameTranslation
+	5,   // 43: catalog.GameMediaResponse.media:type_name -> catalog.GameMedia
+	71,  // 44: catalog.ListGamesRequest.released_after:type_name -> google.protobuf.Timestamp
+	71,  // 45: catalog.ListGamesRequest.released_before:type_name -> google.protobuf.Timestamp
+	0,   // 46: catalog.ListGamesResponse.games:type_name -> catalog.Game
+	41,  // 47: catalog.ListGamesResponse.facets:type_name -> catalog.GameFacets
+	40,  // 48: catalog.GameFacets.genres:type_name -> catalog.FacetCount
+	40,  // 49: catalog.GameFacets.platforms:type_name -> catalog.FacetCount
+	40,  // 50: catalog.GameFacets.developers:type_name -> catalog.FacetCount
+	40,  // 51: catalog.GameFacets.publishers:type_name -> catalog.FacetCount
+	40,  // 52: catalog.GameFacets.ratings:type_name -> catalog.FacetCount
+	40,  // 53: catalog.GameFacets.tags:type_name -> catalog.FacetCount
+	43,  // 54: catalog.AutocompleteTitlesResponse.suggestions:type_name -> catalog.TitleSuggestion
+	11,  // 55: catalog.GenresResponse.genres:type_name -> catalog.Genre
+	12,  // 56: catalog.PlatformsResponse.platforms:type_name -> catalog.Platform
+	16,  // 57: catalog.CompaniesResponse.companies:type_name -> catalog.Company
+	13,  // 58: catalog.FranchisesResponse.franchises:type_name -> catalog.Franchise
+	7,   // 59: catalog.TagsResponse.tags:type_name -> catalog.Tag
+	17,  // 60: catalog.CatalogService.CreateGame:input_type -> catalog.CreateGameRequest
+	18,  // 61: catalog.CatalogService.GetGame:input_type -> catalog.GetGameRequest
+	19,  // 62: catalog.CatalogService.GetGameByExternalID:input_type -> catalog.GetGameByExternalIDRequest
+	20,  // 63: catalog.CatalogService.UpdateGame:input_type -> catalog.UpdateGameRequest
+	32,  // 64: catalog.CatalogService.DeleteGame:input_type -> catalog.DeleteGameRequest
+	33,  // 65: catalog.CatalogService.RestoreGame:input_type -> catalog.RestoreGameRequest
+	38,  // 66: catalog.CatalogService.ListGames:input_type -> catalog.ListGamesRequest
+	42,  // 67: catalog.CatalogService.AutocompleteTitles:input_type -> catalog.AutocompleteTitlesRequest
+	34,  // 68: catalog.CatalogService.AddGameGenre:input_type -> catalog.GameGenreRequest
+	34,  // 69: catalog.CatalogService.RemoveGameGenre:input_type -> catalog.GameGenreRequest
+	35,  // 70: catalog.CatalogService.AddGamePlatform:input_type -> catalog.GamePlatformRequest
+	35,  // 71: catalog.CatalogService.RemoveGamePlatform:input_type -> catalog.GamePlatformRequest
+	36,  // 72: catalog.CatalogService.AddGameRelation:input_type -> catalog.AddGameRelationRequest
+	37,  // 73: catalog.CatalogService.RemoveGameRelation:input_type -> catalog.RemoveGameRelationRequest
+	21,  // 74: catalog.CatalogService.ListGameTranslations:input_type -> catalog.ListGameTranslationsRequest
+	23,  // 75: catalog.CatalogService.SetGameTranslation:input_type -> catalog.SetGameTranslationRequest
+	24,  // 76: catalog.CatalogService.DeleteGameTranslation:input_type -> catalog.DeleteGameTranslationRequest
+	25,  // 77: catalog.CatalogService.ListGameMedia:input_type -> catalog.ListGameMediaRequest
+	27,  // 78: catalog.CatalogService.AddGameMedia:input_type -> catalog.AddGameMediaRequest
+	28,  // 79: catalog.CatalogService.ReorderGameMedia:input_type -> catalog.ReorderGameMediaRequest
+	29,  // 80: catalog.CatalogService.RemoveGameMedia:input_type -> catalog.RemoveGameMediaRequest
+	30,  // 81: catalog.CatalogService.VoteGameTag:input_type -> catalog.VoteGameTagRequest
+	31,  // 82: catalog.CatalogService.RemoveGameTagVote:input_type -> catalog.RemoveGameTagVoteRequest
+	45,  // 83: catalog.CatalogService.CreateGenre:input_type -> catalog.CreateGenreRequest
+	72,  // 84: catalog.CatalogService.GetAllGenres:input_type -> google.protobuf.Empty
+	47,  // 85: catalog.CatalogService.GetGenre:input_type -> catalog.GetGenreRequest
+	48,  // 86: catalog.CatalogService.UpdateGenre:input_type -> catalog.UpdateGenreRequest
+	49,  // 87: catalog.CatalogService.DeleteGenre:input_type -> catalog.DeleteGenreRequest
+	50,  // 88: catalog.CatalogService.MergeGenres:input_type -> catalog.MergeGenresRequest
+	51,  // 89: catalog.CatalogService.CreatePlatform:input_type -> catalog.CreatePlatformRequest
+	72,  // 90: catalog.CatalogService.GetAllPlatforms:input_type -> google.protobuf.Empty
+	53,  // 91: catalog.CatalogService.GetPlatform:input_type -> catalog.GetPlatformRequest
+	54,  // 92: catalog.CatalogService.UpdatePlatform:input_type -> catalog.UpdatePlatformRequest
+	55,  // 93: catalog.CatalogService.DeletePlatform:input_type -> catalog.DeletePlatformRequest
+	56,  // 94: catalog.CatalogService.MergePlatforms:input_type -> catalog.MergePlatformsRequest
+	57,  // 95: catalog.CatalogService.CreateCompany:input_type -> catalog.CreateCompanyRequest
+	72,  // 96: catalog.CatalogService.GetAllCompanies:input_type -> google.protobuf.Empty
+	59,  // 97: catalog.CatalogService.GetCompany:input_type -> catalog.GetCompanyRequest
+	60,  // 98: catalog.CatalogService.UpdateCompany:input_type -> catalog.UpdateCompanyRequest
+	61,  // 99: catalog.CatalogService.DeleteCompany:input_type -> catalog.DeleteCompanyRequest
+	62,  // 100: catalog.CatalogService.CreateFranchise:input_type -> catalog.CreateFranchiseRequest
+	72,  // 101: catalog.CatalogService.GetAllFranchises:input_type -> google.protobuf.Empty
+	64,  // 102: catalog.CatalogService.GetFranchise:input_type -> catalog.GetFranchiseRequest
+	65,  // 103: catalog.CatalogService.UpdateFranchise:input_type -> catalog.UpdateFranchiseRequest
+	66,  // 104: catalog.CatalogService.DeleteFranchise:input_type -> catalog.DeleteFranchiseRequest
+	67,  // 105: catalog.CatalogService.GetTags:input_type -> catalog.GetTagsRequest
+	69,  // 106: catalog.CatalogService.SuggestTag:input_type -> catalog.SuggestTagRequest
+	70,  // 107: catalog.CatalogService.ApproveTag:input_type -> catalog.ModerateTagRequest
+	70,  // 108: catalog.CatalogService.RejectTag:input_type -> catalog.ModerateTagRequest
+	0,   // 109: catalog.CatalogService.CreateGame:output_type -> catalog.Game
+	0,   // 110: catalog.CatalogService.GetGame:output_type -> catalog.Game
+	0,   // 111: catalog.CatalogService.GetGameByExternalID:output_type -> catalog.Game
+	0,   // 112: catalog.CatalogService.UpdateGame:output_type -> catalog.Game
+	72,  // 113: catalog.CatalogService.DeleteGame:output_type -> google.protobuf.Empty
+	0,   // 114: catalog.CatalogService.RestoreGame:output_type -> catalog.Game
+	39,  // 115: catalog.CatalogService.ListGames:output_type -> catalog.ListGamesResponse
+	44,  // 116: catalog.CatalogService.AutocompleteTitles:output_type -> catalog.AutocompleteTitlesResponse
+	0,   // 117: catalog.CatalogService.AddGameGenre:output_type -> catalog.Game
+	0,   // 118: catalog.CatalogService.RemoveGameGenre:output_type -> catalog.Game
+	0,   // 119: catalog.CatalogService.AddGamePlatform:output_type -> catalog.Game
+	0,   // 120: catalog.CatalogService.RemoveGamePlatform:output_type -> catalog.Game
+	14,  // 121: catalog.CatalogService.AddGameRelation:output_type -> catalog.GameRelation
+	72,  // 122: catalog.CatalogService.RemoveGameRelation:output_type -> google.protobuf.Empty
+	22,  // 123: catalog.CatalogService.ListGameTranslations:output_type -> catalog.GameTranslationsResponse
+	4,   // 124: catalog.CatalogService.SetGameTranslation:output_type -> catalog.GameTranslation
+	72,  // 125: catalog.CatalogService.DeleteGameTranslation:output_type -> google.protobuf.Empty
+	26,  // 126: catalog.CatalogService.ListGameMedia:output_type -> catalog.GameMediaResponse
+	5,   // 127: catalog.CatalogService.AddGameMedia:output_type -> catalog.GameMedia
+	26,  // 128: catalog.CatalogService.ReorderGameMedia:output_type -> catalog.GameMediaResponse
+	72,  // 129: catalog.CatalogService.RemoveGameMedia:output_type -> google.protobuf.Empty
+	8,   // 130: catalog.CatalogService.VoteGameTag:output_type -> catalog.GameTag
+	72,  // 131: catalog.CatalogService.RemoveGameTagVote:output_type -> google.protobuf.Empty
+	11,  // 132: catalog.CatalogService.CreateGenre:output_type -> catalog.Genre
+	46,  // 133: catalog.CatalogService.GetAllGenres:output_type -> catalog.GenresResponse
+	11,  // 134: catalog.CatalogService.GetGenre:output_type -> catalog.Genre
+	11,  // 135: catalog.CatalogService.UpdateGenre:output_type -> catalog.Genre
+	72,  // 136: catalog.CatalogService.DeleteGenre:output_type -> google.protobuf.Empty
+	11,  // 137: catalog.CatalogService.MergeGenres:output_type -> catalog.Genre
+	12,  // 138: catalog.CatalogService.CreatePlatform:output_type -> catalog.Platform
+	52,  // 139: catalog.CatalogService.GetAllPlatforms:output_type -> catalog.PlatformsResponse
+	12,  // 140: catalog.CatalogService.GetPlatform:output_type -> catalog.Platform
+	12,  // 141: catalog.CatalogService.UpdatePlatform:output_type -> catalog.Platform
+	72,  // 142: catalog.CatalogService.DeletePlatform:output_type -> google.protobuf.Empty
+	12,  // 143: catalog.CatalogService.MergePlatforms:output_type -> catalog.Platform
+	16,  // 144: catalog.CatalogService.CreateCompany:output_type -> catalog.Company
+	58,  // 145: catalog.CatalogService.GetAllCompanies:output_type -> catalog.CompaniesResponse
+	16,  // 146: catalog.CatalogService.GetCompany:output_type -> catalog.Company
+	16,  // 147: catalog.CatalogService.UpdateCompany:output_type -> catalog.Company
+	72,  // 148: catalog.CatalogService.DeleteCompany:output_type -> google.protobuf.Empty
+	13,  // 149: catalog.CatalogService.CreateFranchise:output_type -> catalog.Franchise
+	63,  // 150: catalog.CatalogService.GetAllFranchises:output_type -> catalog.FranchisesResponse
+	13,  // 151: catalog.CatalogService.GetFranchise:output_type -> catalog.Franchise
+	13,  // 152: catalog.CatalogService.UpdateFranchise:output_type -> catalog.Franchise
+	72,  // 153: catalog.CatalogService.DeleteFranchise:output_type -> google.protobuf.Empty
+	68,  // 154: catalog.CatalogService.GetTags:output_type -> catalog.TagsResponse
+	7,   // 155: catalog.CatalogService.SuggestTag:output_type -> catalog.Tag
+	7,   // 156: catalog.CatalogService.ApproveTag:output_type -> catalog.Tag
+	7,   // 157: catalog.CatalogService.RejectTag:output_type -> catalog.Tag
+	109, // [109:158] is the sub-list for method output_type
+	60,  // [60:109] is the sub-list for method input_type
+	60,  // [60:60] is the sub-list for extension type_name
+	60,  // [60:60] is the sub-list for extension extendee
+	0,   // [0:60] is the sub-list for field type_name
+}
+
+func init() { file_catalog_proto_init() }
+func file_catalog_proto_init() {
+	if File_catalog_proto != nil {
+		return
+	}
+	file_catalog_proto_msgTypes[0].OneofWrappers = []any{}
+	file_catalog_proto_msgTypes[17].OneofWrappers = []any{}
+	file_catalog_proto_msgTypes[20].OneofWrappers = []any{}
+	file_catalog_proto_msgTypes[38].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_catalog_proto_rawDesc), len(file_catalog_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   71,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_catalog_proto_goTypes,
+		DependencyIndexes: file_catalog_proto_depIdxs,
+		MessageInfos:      file_catalog_proto_msgTypes,
+	}.Build()
+	File_catalog_proto = out.File
+	file_catalog_proto_goTypes = nil
+	file_catalog_proto_depIdxs = nil
+}
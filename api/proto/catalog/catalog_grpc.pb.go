@@ -0,0 +1,1946 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: catalog.proto
+
+package catalog
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CatalogService_CreateGame_FullMethodName            = "/catalog.CatalogService/CreateGame"
+	CatalogService_GetGame_FullMethodName               = "/catalog.CatalogService/GetGame"
+	CatalogService_GetGameByExternalID_FullMethodName   = "/catalog.CatalogService/GetGameByExternalID"
+	CatalogService_UpdateGame_FullMethodName            = "/catalog.CatalogService/UpdateGame"
+	CatalogService_DeleteGame_FullMethodName            = "/catalog.CatalogService/DeleteGame"
+	CatalogService_RestoreGame_FullMethodName           = "/catalog.CatalogService/RestoreGame"
+	CatalogService_ListGames_FullMethodName             = "/catalog.CatalogService/ListGames"
+	CatalogService_AutocompleteTitles_FullMethodName    = "/catalog.CatalogService/AutocompleteTitles"
+	CatalogService_AddGameGenre_FullMethodName          = "/catalog.CatalogService/AddGameGenre"
+	CatalogService_RemoveGameGenre_FullMethodName       = "/catalog.CatalogService/RemoveGameGenre"
+	CatalogService_AddGamePlatform_FullMethodName       = "/catalog.CatalogService/AddGamePlatform"
+	CatalogService_RemoveGamePlatform_FullMethodName    = "/catalog.CatalogService/RemoveGamePlatform"
+	CatalogService_AddGameRelation_FullMethodName       = "/catalog.CatalogService/AddGameRelation"
+	CatalogService_RemoveGameRelation_FullMethodName    = "/catalog.CatalogService/RemoveGameRelation"
+	CatalogService_ListGameTranslations_FullMethodName  = "/catalog.CatalogService/ListGameTranslations"
+	CatalogService_SetGameTranslation_FullMethodName    = "/catalog.CatalogService/SetGameTranslation"
+	CatalogService_DeleteGameTranslation_FullMethodName = "/catalog.CatalogService/DeleteGameTranslation"
+	CatalogService_ListGameMedia_FullMethodName         = "/catalog.CatalogService/ListGameMedia"
+	CatalogService_AddGameMedia_FullMethodName          = "/catalog.CatalogService/AddGameMedia"
+	CatalogService_ReorderGameMedia_FullMethodName      = "/catalog.CatalogService/ReorderGameMedia"
+	CatalogService_RemoveGameMedia_FullMethodName       = "/catalog.CatalogService/RemoveGameMedia"
+	CatalogService_VoteGameTag_FullMethodName           = "/catalog.CatalogService/VoteGameTag"
+	CatalogService_RemoveGameTagVote_FullMethodName     = "/catalog.CatalogService/RemoveGameTagVote"
+	CatalogService_CreateGenre_FullMethodName           = "/catalog.CatalogService/CreateGenre"
+	CatalogService_GetAllGenres_FullMethodName          = "/catalog.CatalogService/GetAllGenres"
+	CatalogService_GetGenre_FullMethodName              = "/catalog.CatalogService/GetGenre"
+	CatalogService_UpdateGenre_FullMethodName           = "/catalog.CatalogService/UpdateGenre"
+	CatalogService_DeleteGenre_FullMethodName           = "/catalog.CatalogService/DeleteGenre"
+	CatalogService_MergeGenres_FullMethodName           = "/catalog.CatalogService/MergeGenres"
+	CatalogService_CreatePlatform_FullMethodName        = "/catalog.CatalogService/CreatePlatform"
+	CatalogService_GetAllPlatforms_FullMethodName       = "/catalog.CatalogService/GetAllPlatforms"
+	CatalogService_GetPlatform_FullMethodName           = "/catalog.CatalogService/GetPlatform"
+	CatalogService_UpdatePlatform_FullMethodName        = "/catalog.CatalogService/UpdatePlatform"
+	CatalogService_DeletePlatform_FullMethodName        = "/catalog.CatalogService/DeletePlatform"
+	CatalogService_MergePlatforms_FullMethodName        = "/catalog.CatalogService/MergePlatforms"
+	CatalogService_CreateCompany_FullMethodName         = "/catalog.CatalogService/CreateCompany"
+	CatalogService_GetAllCompanies_FullMethodName       = "/catalog.CatalogService/GetAllCompanies"
+	CatalogService_GetCompany_FullMethodName            = "/catalog.CatalogService/GetCompany"
+	CatalogService_UpdateCompany_FullMethodName         = "/catalog.CatalogService/UpdateCompany"
+	CatalogService_DeleteCompany_FullMethodName         = "/catalog.CatalogService/DeleteCompany"
+	CatalogService_CreateFranchise_FullMethodName       = "/catalog.CatalogService/CreateFranchise"
+	CatalogService_GetAllFranchises_FullMethodName      = "/catalog.CatalogService/GetAllFranchises"
+	CatalogService_GetFranchise_FullMethodName          = "/catalog.CatalogService/GetFranchise"
+	CatalogService_UpdateFranchise_FullMethodName       = "/catalog.CatalogService/UpdateFranchise"
+	CatalogService_DeleteFranchise_FullMethodName       = "/catalog.CatalogService/DeleteFranchise"
+	CatalogService_GetTags_FullMethodName               = "/catalog.CatalogService/GetTags"
+	CatalogService_SuggestTag_FullMethodName            = "/catalog.CatalogService/SuggestTag"
+	CatalogService_ApproveTag_FullMethodName            = "/catalog.CatalogService/ApproveTag"
+	CatalogService_RejectTag_FullMethodName             = "/catalog.CatalogService/RejectTag"
+)
+
+// CatalogServiceClient is the client API for CatalogService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CatalogServiceClient interface {
+	CreateGame(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (*Game, error)
+	GetGame(ctx context.Context, in *GetGameRequest, opts ...grpc.CallOption) (*Game, error)
+	GetGameByExternalID(ctx context.Context, in *GetGameByExternalIDRequest, opts ...grpc.CallOption) (*Game, error)
+	UpdateGame(ctx context.Context, in *UpdateGameRequest, opts ...grpc.CallOption) (*Game, error)
+	DeleteGame(ctx context.Context, in *DeleteGameRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RestoreGame(ctx context.Context, in *RestoreGameRequest, opts ...grpc.CallOption) (*Game, error)
+	ListGames(ctx context.Context, in *ListGamesRequest, opts ...grpc.CallOption) (*ListGamesResponse, error)
+	AutocompleteTitles(ctx context.Context, in *AutocompleteTitlesRequest, opts ...grpc.CallOption) (*AutocompleteTitlesResponse, error)
+	AddGameGenre(ctx context.Context, in *GameGenreRequest, opts ...grpc.CallOption) (*Game, error)
+	RemoveGameGenre(ctx context.Context, in *GameGenreRequest, opts ...grpc.CallOption) (*Game, error)
+	AddGamePlatform(ctx context.Context, in *GamePlatformRequest, opts ...grpc.CallOption) (*Game, error)
+	RemoveGamePlatform(ctx context.Context, in *GamePlatformRequest, opts ...grpc.CallOption) (*Game, error)
+	AddGameRelation(ctx context.Context, in *AddGameRelationRequest, opts ...grpc.CallOption) (*GameRelation, error)
+	RemoveGameRelation(ctx context.Context, in *RemoveGameRelationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListGameTranslations(ctx context.Context, in *ListGameTranslationsRequest, opts ...grpc.CallOption) (*GameTranslationsResponse, error)
+	SetGameTranslation(ctx context.Context, in *SetGameTranslationRequest, opts ...grpc.CallOption) (*GameTranslation, error)
+	DeleteGameTranslation(ctx context.Context, in *DeleteGameTranslationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListGameMedia(ctx context.Context, in *ListGameMediaRequest, opts ...grpc.CallOption) (*GameMediaResponse, error)
+	AddGameMedia(ctx context.Context, in *AddGameMediaRequest, opts ...grpc.CallOption) (*GameMedia, error)
+	ReorderGameMedia(ctx context.Context, in *ReorderGameMediaRequest, opts ...grpc.CallOption) (*GameMediaResponse, error)
+	RemoveGameMedia(ctx context.Context, in *RemoveGameMediaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	VoteGameTag(ctx context.Context, in *VoteGameTagRequest, opts ...grpc.CallOption) (*GameTag, error)
+	RemoveGameTagVote(ctx context.Context, in *RemoveGameTagVoteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateGenre(ctx context.Context, in *CreateGenreRequest, opts ...grpc.CallOption) (*Genre, error)
+	GetAllGenres(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GenresResponse, error)
+	GetGenre(ctx context.Context, in *GetGenreRequest, opts ...grpc.CallOption) (*Genre, error)
+	UpdateGenre(ctx context.Context, in *UpdateGenreRequest, opts ...grpc.CallOption) (*Genre, error)
+	DeleteGenre(ctx context.Context, in *DeleteGenreRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MergeGenres(ctx context.Context, in *MergeGenresRequest, opts ...grpc.CallOption) (*Genre, error)
+	CreatePlatform(ctx context.Context, in *CreatePlatformRequest, opts ...grpc.CallOption) (*Platform, error)
+	GetAllPlatforms(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PlatformsResponse, error)
+	GetPlatform(ctx context.Context, in *GetPlatformRequest, opts ...grpc.CallOption) (*Platform, error)
+	UpdatePlatform(ctx context.Context, in *UpdatePlatformRequest, opts ...grpc.CallOption) (*Platform, error)
+	DeletePlatform(ctx context.Context, in *DeletePlatformRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MergePlatforms(ctx context.Context, in *MergePlatformsRequest, opts ...grpc.CallOption) (*Platform, error)
+	CreateCompany(ctx context.Context, in *CreateCompanyRequest, opts ...grpc.CallOption) (*Company, error)
+	GetAllCompanies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CompaniesResponse, error)
+	GetCompany(ctx context.Context, in *GetCompanyRequest, opts ...grpc.CallOption) (*Company, error)
+	UpdateCompany(ctx context.Context, in *UpdateCompanyRequest, opts ...grpc.CallOption) (*Company, error)
+	DeleteCompany(ctx context.Context, in *DeleteCompanyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateFranchise(ctx context.Context, in *CreateFranchiseRequest, opts ...grpc.CallOption) (*Franchise, error)
+	GetAllFranchises(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FranchisesResponse, error)
+	GetFranchise(ctx context.Context, in *GetFranchiseRequest, opts ...grpc.CallOption) (*Franchise, error)
+	UpdateFranchise(ctx context.Context, in *UpdateFranchiseRequest, opts ...grpc.CallOption) (*Franchise, error)
+	DeleteFranchise(ctx context.Context, in *DeleteFranchiseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetTags(ctx context.Context, in *GetTagsRequest, opts ...grpc.CallOption) (*TagsResponse, error)
+	SuggestTag(ctx context.Context, in *SuggestTagRequest, opts ...grpc.CallOption) (*Tag, error)
+	ApproveTag(ctx context.Context, in *ModerateTagRequest, opts ...grpc.CallOption) (*Tag, error)
+	RejectTag(ctx context.Context, in *ModerateTagRequest, opts ...grpc.CallOption) (*Tag, error)
+}
+
+type catalogServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCatalogServiceClient(cc grpc.ClientConnInterface) CatalogServiceClient {
+	return &catalogServiceClient{cc}
+}
+
+func (c *catalogServiceClient) CreateGame(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (*Game, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Game)
+	err := c.cc.Invoke(ctx, CatalogService_CreateGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetGame(ctx context.Context, in *GetGameRequest, opts ...grpc.CallOption) (*Game, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Game)
+	err := c.cc.Invoke(ctx, CatalogService_GetGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetGameByExternalID(ctx context.Context, in *GetGameByExternalIDRequest, opts ...grpc.CallOption) (*Game, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Game)
+	err := c.cc.Invoke(ctx, CatalogService_GetGameByExternalID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) UpdateGame(ctx context.Context, in *UpdateGameRequest, opts ...grpc.CallOption) (*Game, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Game)
+	err := c.cc.Invoke(ctx, CatalogService_UpdateGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) DeleteGame(ctx context.Context, in *DeleteGameRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CatalogService_DeleteGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) RestoreGame(ctx context.Context, in *RestoreGameRequest, opts ...grpc.CallOption) (*Game, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Game)
+	err := c.cc.Invoke(ctx, CatalogService_RestoreGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListGames(ctx context.Context, in *ListGamesRequest, opts ...grpc.CallOption) (*ListGamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGamesResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListGames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) AutocompleteTitles(ctx context.Context, in *AutocompleteTitlesRequest, opts ...grpc.CallOption) (*AutocompleteTitlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AutocompleteTitlesResponse)
+	err := c.cc.Invoke(ctx, CatalogService_AutocompleteTitles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) AddGameGenre(ctx context.Context, in *GameGenreRequest, opts ...grpc.CallOption) (*Game, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Game)
+	err := c.cc.Invoke(ctx, CatalogService_AddGameGenre_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) RemoveGameGenre(ctx context.Context, in *GameGenreRequest, opts ...grpc.CallOption) (*Game, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Game)
+	err := c.cc.Invoke(ctx, CatalogService_RemoveGameGenre_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) AddGamePlatform(ctx context.Context, in *GamePlatformRequest, opts ...grpc.CallOption) (*Game, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Game)
+	err := c.cc.Invoke(ctx, CatalogService_AddGamePlatform_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) RemoveGamePlatform(ctx context.Context, in *GamePlatformRequest, opts ...grpc.CallOption) (*Game, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Game)
+	err := c.cc.Invoke(ctx, CatalogService_RemoveGamePlatform_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) AddGameRelation(ctx context.Context, in *AddGameRelationRequest, opts ...grpc.CallOption) (*GameRelation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameRelation)
+	err := c.cc.Invoke(ctx, CatalogService_AddGameRelation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) RemoveGameRelation(ctx context.Context, in *RemoveGameRelationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CatalogService_RemoveGameRelation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListGameTranslations(ctx context.Context, in *ListGameTranslationsRequest, opts ...grpc.CallOption) (*GameTranslationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameTranslationsResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListGameTranslations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) SetGameTranslation(ctx context.Context, in *SetGameTranslationRequest, opts ...grpc.CallOption) (*GameTranslation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameTranslation)
+	err := c.cc.Invoke(ctx, CatalogService_SetGameTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) DeleteGameTranslation(ctx context.Context, in *DeleteGameTranslationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CatalogService_DeleteGameTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListGameMedia(ctx context.Context, in *ListGameMediaRequest, opts ...grpc.CallOption) (*GameMediaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameMediaResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListGameMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) AddGameMedia(ctx context.Context, in *AddGameMediaRequest, opts ...grpc.CallOption) (*GameMedia, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameMedia)
+	err := c.cc.Invoke(ctx, CatalogService_AddGameMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ReorderGameMedia(ctx context.Context, in *ReorderGameMediaRequest, opts ...grpc.CallOption) (*GameMediaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameMediaResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ReorderGameMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) RemoveGameMedia(ctx context.Context, in *RemoveGameMediaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CatalogService_RemoveGameMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) VoteGameTag(ctx context.Context, in *VoteGameTagRequest, opts ...grpc.CallOption) (*GameTag, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameTag)
+	err := c.cc.Invoke(ctx, CatalogService_VoteGameTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) RemoveGameTagVote(ctx context.Context, in *RemoveGameTagVoteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CatalogService_RemoveGameTagVote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) CreateGenre(ctx context.Context, in *CreateGenreRequest, opts ...grpc.CallOption) (*Genre, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Genre)
+	err := c.cc.Invoke(ctx, CatalogService_CreateGenre_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetAllGenres(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GenresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenresResponse)
+	err := c.cc.Invoke(ctx, CatalogService_GetAllGenres_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetGenre(ctx context.Context, in *GetGenreRequest, opts ...grpc.CallOption) (*Genre, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Genre)
+	err := c.cc.Invoke(ctx, CatalogService_GetGenre_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) UpdateGenre(ctx context.Context, in *UpdateGenreRequest, opts ...grpc.CallOption) (*Genre, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Genre)
+	err := c.cc.Invoke(ctx, CatalogService_UpdateGenre_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) DeleteGenre(ctx context.Context, in *DeleteGenreRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CatalogService_DeleteGenre_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) MergeGenres(ctx context.Context, in *MergeGenresRequest, opts ...grpc.CallOption) (*Genre, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Genre)
+	err := c.cc.Invoke(ctx, CatalogService_MergeGenres_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) CreatePlatform(ctx context.Context, in *CreatePlatformRequest, opts ...grpc.CallOption) (*Platform, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Platform)
+	err := c.cc.Invoke(ctx, CatalogService_CreatePlatform_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetAllPlatforms(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PlatformsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlatformsResponse)
+	err := c.cc.Invoke(ctx, CatalogService_GetAllPlatforms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetPlatform(ctx context.Context, in *GetPlatformRequest, opts ...grpc.CallOption) (*Platform, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Platform)
+	err := c.cc.Invoke(ctx, CatalogService_GetPlatform_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) UpdatePlatform(ctx context.Context, in *UpdatePlatformRequest, opts ...grpc.CallOption) (*Platform, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Platform)
+	err := c.cc.Invoke(ctx, CatalogService_UpdatePlatform_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) DeletePlatform(ctx context.Context, in *DeletePlatformRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CatalogService_DeletePlatform_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) MergePlatforms(ctx context.Context, in *MergePlatformsRequest, opts ...grpc.CallOption) (*Platform, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Platform)
+	err := c.cc.Invoke(ctx, CatalogService_MergePlatforms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) CreateCompany(ctx context.Context, in *CreateCompanyRequest, opts ...grpc.CallOption) (*Company, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Company)
+	err := c.cc.Invoke(ctx, CatalogService_CreateCompany_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetAllCompanies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CompaniesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompaniesResponse)
+	err := c.cc.Invoke(ctx, CatalogService_GetAllCompanies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetCompany(ctx context.Context, in *GetCompanyRequest, opts ...grpc.CallOption) (*Company, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Company)
+	err := c.cc.Invoke(ctx, CatalogService_GetCompany_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) UpdateCompany(ctx context.Context, in *UpdateCompanyRequest, opts ...grpc.CallOption) (*Company, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Company)
+	err := c.cc.Invoke(ctx, CatalogService_UpdateCompany_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) DeleteCompany(ctx context.Context, in *DeleteCompanyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CatalogService_DeleteCompany_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) CreateFranchise(ctx context.Context, in *CreateFranchiseRequest, opts ...grpc.CallOption) (*Franchise, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Franchise)
+	err := c.cc.Invoke(ctx, CatalogService_CreateFranchise_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetAllFranchises(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FranchisesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FranchisesResponse)
+	err := c.cc.Invoke(ctx, CatalogService_GetAllFranchises_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetFranchise(ctx context.Context, in *GetFranchiseRequest, opts ...grpc.CallOption) (*Franchise, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Franchise)
+	err := c.cc.Invoke(ctx, CatalogService_GetFranchise_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) UpdateFranchise(ctx context.Context, in *UpdateFranchiseRequest, opts ...grpc.CallOption) (*Franchise, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Franchise)
+	err := c.cc.Invoke(ctx, CatalogService_UpdateFranchise_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) DeleteFranchise(ctx context.Context, in *DeleteFranchiseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CatalogService_DeleteFranchise_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetTags(ctx context.Context, in *GetTagsRequest, opts ...grpc.CallOption) (*TagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagsResponse)
+	err := c.cc.Invoke(ctx, CatalogService_GetTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) SuggestTag(ctx context.Context, in *SuggestTagRequest, opts ...grpc.CallOption) (*Tag, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tag)
+	err := c.cc.Invoke(ctx, CatalogService_SuggestTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ApproveTag(ctx context.Context, in *ModerateTagRequest, opts ...grpc.CallOption) (*Tag, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tag)
+	err := c.cc.Invoke(ctx, CatalogService_ApproveTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) RejectTag(ctx context.Context, in *ModerateTagRequest, opts ...grpc.CallOption) (*Tag, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tag)
+	err := c.cc.Invoke(ctx, CatalogService_RejectTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CatalogServiceServer is the server API for CatalogService service.
+// All implementations must embed UnimplementedCatalogServiceServer
+// for forward compatibility.
+type CatalogServiceServer interface {
+	CreateGame(context.Context, *CreateGameRequest) (*Game, error)
+	GetGame(context.Context, *GetGameRequest) (*Game, error)
+	GetGameByExternalID(context.Context, *GetGameByExternalIDRequest) (*Game, error)
+	UpdateGame(context.Context, *UpdateGameRequest) (*Game, error)
+	DeleteGame(context.Context, *DeleteGameRequest) (*emptypb.Empty, error)
+	RestoreGame(context.Context, *RestoreGameRequest) (*Game, error)
+	ListGames(context.Context, *ListGamesRequest) (*ListGamesResponse, error)
+	AutocompleteTitles(context.Context, *AutocompleteTitlesRequest) (*AutocompleteTitlesResponse, error)
+	AddGameGenre(context.Context, *GameGenreRequest) (*Game, error)
+	RemoveGameGenre(context.Context, *GameGenreRequest) (*Game, error)
+	AddGamePlatform(context.Context, *GamePlatformRequest) (*Game, error)
+	RemoveGamePlatform(context.Context, *GamePlatformRequest) (*Game, error)
+	AddGameRelation(context.Context, *AddGameRelationRequest) (*GameRelation, error)
+	RemoveGameRelation(context.Context, *RemoveGameRelationRequest) (*emptypb.Empty, error)
+	ListGameTranslations(context.Context, *ListGameTranslationsRequest) (*GameTranslationsResponse, error)
+	SetGameTranslation(context.Context, *SetGameTranslationRequest) (*GameTranslation, error)
+	DeleteGameTranslation(context.Context, *DeleteGameTranslationRequest) (*emptypb.Empty, error)
+	ListGameMedia(context.Context, *ListGameMediaRequest) (*GameMediaResponse, error)
+	AddGameMedia(context.Context, *AddGameMediaRequest) (*GameMedia, error)
+	ReorderGameMedia(context.Context, *ReorderGameMediaRequest) (*GameMediaResponse, error)
+	RemoveGameMedia(context.Context, *RemoveGameMediaRequest) (*emptypb.Empty, error)
+	VoteGameTag(context.Context, *VoteGameTagRequest) (*GameTag, error)
+	RemoveGameTagVote(context.Context, *RemoveGameTagVoteRequest) (*emptypb.Empty, error)
+	CreateGenre(context.Context, *CreateGenreRequest) (*Genre, error)
+	GetAllGenres(context.Context, *emptypb.Empty) (*GenresResponse, error)
+	GetGenre(context.Context, *GetGenreRequest) (*Genre, error)
+	UpdateGenre(context.Context, *UpdateGenreRequest) (*Genre, error)
+	DeleteGenre(context.Context, *DeleteGenreRequest) (*emptypb.Empty, error)
+	MergeGenres(context.Context, *MergeGenresRequest) (*Genre, error)
+	CreatePlatform(context.Context, *CreatePlatformRequest) (*Platform, error)
+	GetAllPlatforms(context.Context, *emptypb.Empty) (*PlatformsResponse, error)
+	GetPlatform(context.Context, *GetPlatformRequest) (*Platform, error)
+	UpdatePlatform(context.Context, *UpdatePlatformRequest) (*Platform, error)
+	DeletePlatform(context.Context, *DeletePlatformRequest) (*emptypb.Empty, error)
+	MergePlatforms(context.Context, *MergePlatformsRequest) (*Platform, error)
+	CreateCompany(context.Context, *CreateCompanyRequest) (*Company, error)
+	GetAllCompanies(context.Context, *emptypb.Empty) (*CompaniesResponse, error)
+	GetCompany(context.Context, *GetCompanyRequest) (*Company, error)
+	UpdateCompany(context.Context, *UpdateCompanyRequest) (*Company, error)
+	DeleteCompany(context.Context, *DeleteCompanyRequest) (*emptypb.Empty, error)
+	CreateFranchise(context.Context, *CreateFranchiseRequest) (*Franchise, error)
+	GetAllFranchises(context.Context, *emptypb.Empty) (*FranchisesResponse, error)
+	GetFranchise(context.Context, *GetFranchiseRequest) (*Franchise, error)
+	UpdateFranchise(context.Context, *UpdateFranchiseRequest) (*Franchise, error)
+	DeleteFranchise(context.Context, *DeleteFranchiseRequest) (*emptypb.Empty, error)
+	GetTags(context.Context, *GetTagsRequest) (*TagsResponse, error)
+	SuggestTag(context.Context, *SuggestTagRequest) (*Tag, error)
+	ApproveTag(context.Context, *ModerateTagRequest) (*Tag, error)
+	RejectTag(context.Context, *ModerateTagRequest) (*Tag, error)
+	mustEmbedUnimplementedCatalogServiceServer()
+}
+
+// UnimplementedCatalogServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCatalogServiceServer struct{}
+
+func (UnimplementedCatalogServiceServer) CreateGame(context.Context, *CreateGameRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGame not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetGame(context.Context, *GetGameRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGame not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetGameByExternalID(context.Context, *GetGameByExternalIDRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameByExternalID not implemented")
+}
+func (UnimplementedCatalogServiceServer) UpdateGame(context.Context, *UpdateGameRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGame not implemented")
+}
+func (UnimplementedCatalogServiceServer) DeleteGame(context.Context, *DeleteGameRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGame not implemented")
+}
+func (UnimplementedCatalogServiceServer) RestoreGame(context.Context, *RestoreGameRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreGame not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListGames(context.Context, *ListGamesRequest) (*ListGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGames not implemented")
+}
+func (UnimplementedCatalogServiceServer) AutocompleteTitles(context.Context, *AutocompleteTitlesRequest) (*AutocompleteTitlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutocompleteTitles not implemented")
+}
+func (UnimplementedCatalogServiceServer) AddGameGenre(context.Context, *GameGenreRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGameGenre not implemented")
+}
+func (UnimplementedCatalogServiceServer) RemoveGameGenre(context.Context, *GameGenreRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGameGenre not implemented")
+}
+func (UnimplementedCatalogServiceServer) AddGamePlatform(context.Context, *GamePlatformRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGamePlatform not implemented")
+}
+func (UnimplementedCatalogServiceServer) RemoveGamePlatform(context.Context, *GamePlatformRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGamePlatform not implemented")
+}
+func (UnimplementedCatalogServiceServer) AddGameRelation(context.Context, *AddGameRelationRequest) (*GameRelation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGameRelation not implemented")
+}
+func (UnimplementedCatalogServiceServer) RemoveGameRelation(context.Context, *RemoveGameRelationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGameRelation not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListGameTranslations(context.Context, *ListGameTranslationsRequest) (*GameTranslationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGameTranslations not implemented")
+}
+func (UnimplementedCatalogServiceServer) SetGameTranslation(context.Context, *SetGameTranslationRequest) (*GameTranslation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGameTranslation not implemented")
+}
+func (UnimplementedCatalogServiceServer) DeleteGameTranslation(context.Context, *DeleteGameTranslationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGameTranslation not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListGameMedia(context.Context, *ListGameMediaRequest) (*GameMediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGameMedia not implemented")
+}
+func (UnimplementedCatalogServiceServer) AddGameMedia(context.Context, *AddGameMediaRequest) (*GameMedia, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGameMedia not implemented")
+}
+func (UnimplementedCatalogServiceServer) ReorderGameMedia(context.Context, *ReorderGameMediaRequest) (*GameMediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderGameMedia not implemented")
+}
+func (UnimplementedCatalogServiceServer) RemoveGameMedia(context.Context, *RemoveGameMediaRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGameMedia not implemented")
+}
+func (UnimplementedCatalogServiceServer) VoteGameTag(context.Context, *VoteGameTagRequest) (*GameTag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteGameTag not implemented")
+}
+func (UnimplementedCatalogServiceServer) RemoveGameTagVote(context.Context, *RemoveGameTagVoteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGameTagVote not implemented")
+}
+func (UnimplementedCatalogServiceServer) CreateGenre(context.Context, *CreateGenreRequest) (*Genre, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGenre not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetAllGenres(context.Context, *emptypb.Empty) (*GenresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllGenres not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetGenre(context.Context, *GetGenreRequest) (*Genre, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGenre not implemented")
+}
+func (UnimplementedCatalogServiceServer) UpdateGenre(context.Context, *UpdateGenreRequest) (*Genre, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGenre not implemented")
+}
+func (UnimplementedCatalogServiceServer) DeleteGenre(context.Context, *DeleteGenreRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGenre not implemented")
+}
+func (UnimplementedCatalogServiceServer) MergeGenres(context.Context, *MergeGenresRequest) (*Genre, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeGenres not implemented")
+}
+func (UnimplementedCatalogServiceServer) CreatePlatform(context.Context, *CreatePlatformRequest) (*Platform, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlatform not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetAllPlatforms(context.Context, *emptypb.Empty) (*PlatformsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllPlatforms not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetPlatform(context.Context, *GetPlatformRequest) (*Platform, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlatform not implemented")
+}
+func (UnimplementedCatalogServiceServer) UpdatePlatform(context.Context, *UpdatePlatformRequest) (*Platform, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePlatform not implemented")
+}
+func (UnimplementedCatalogServiceServer) DeletePlatform(context.Context, *DeletePlatformRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlatform not implemented")
+}
+func (UnimplementedCatalogServiceServer) MergePlatforms(context.Context, *MergePlatformsRequest) (*Platform, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergePlatforms not implemented")
+}
+func (UnimplementedCatalogServiceServer) CreateCompany(context.Context, *CreateCompanyRequest) (*Company, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCompany not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetAllCompanies(context.Context, *emptypb.Empty) (*CompaniesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCompanies not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetCompany(context.Context, *GetCompanyRequest) (*Company, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompany not implemented")
+}
+func (UnimplementedCatalogServiceServer) UpdateCompany(context.Context, *UpdateCompanyRequest) (*Company, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCompany not implemented")
+}
+func (UnimplementedCatalogServiceServer) DeleteCompany(context.Context, *DeleteCompanyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCompany not implemented")
+}
+func (UnimplementedCatalogServiceServer) CreateFranchise(context.Context, *CreateFranchiseRequest) (*Franchise, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFranchise not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetAllFranchises(context.Context, *emptypb.Empty) (*FranchisesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllFranchises not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetFranchise(context.Context, *GetFranchiseRequest) (*Franchise, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFranchise not implemented")
+}
+func (UnimplementedCatalogServiceServer) UpdateFranchise(context.Context, *UpdateFranchiseRequest) (*Franchise, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFranchise not implemented")
+}
+func (UnimplementedCatalogServiceServer) DeleteFranchise(context.Context, *DeleteFranchiseRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFranchise not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetTags(context.Context, *GetTagsRequest) (*TagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTags not implemented")
+}
+func (UnimplementedCatalogServiceServer) SuggestTag(context.Context, *SuggestTagRequest) (*Tag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestTag not implemented")
+}
+func (UnimplementedCatalogServiceServer) ApproveTag(context.Context, *ModerateTagRequest) (*Tag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveTag not implemented")
+}
+func (UnimplementedCatalogServiceServer) RejectTag(context.Context, *ModerateTagRequest) (*Tag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectTag not implemented")
+}
+func (UnimplementedCatalogServiceServer) mustEmbedUnimplementedCatalogServiceServer() {}
+func (UnimplementedCatalogServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeCatalogServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CatalogServiceServer will
+// result in compilation errors.
+type UnsafeCatalogServiceServer interface {
+	mustEmbedUnimplementedCatalogServiceServer()
+}
+
+func RegisterCatalogServiceServer(s grpc.ServiceRegistrar, srv CatalogServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCatalogServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CatalogService_ServiceDesc, srv)
+}
+
+func _CatalogService_CreateGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).CreateGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_CreateGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).CreateGame(ctx, req.(*CreateGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetGame(ctx, req.(*GetGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetGameByExternalID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameByExternalIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetGameByExternalID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetGameByExternalID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetGameByExternalID(ctx, req.(*GetGameByExternalIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_UpdateGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).UpdateGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_UpdateGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).UpdateGame(ctx, req.(*UpdateGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_DeleteGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).DeleteGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_DeleteGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).DeleteGame(ctx, req.(*DeleteGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_RestoreGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).RestoreGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_RestoreGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).RestoreGame(ctx, req.(*RestoreGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListGames(ctx, req.(*ListGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_AutocompleteTitles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutocompleteTitlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).AutocompleteTitles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_AutocompleteTitles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).AutocompleteTitles(ctx, req.(*AutocompleteTitlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_AddGameGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameGenreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).AddGameGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_AddGameGenre_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).AddGameGenre(ctx, req.(*GameGenreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_RemoveGameGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameGenreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).RemoveGameGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_RemoveGameGenre_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).RemoveGameGenre(ctx, req.(*GameGenreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_AddGamePlatform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GamePlatformRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).AddGamePlatform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_AddGamePlatform_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).AddGamePlatform(ctx, req.(*GamePlatformRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_RemoveGamePlatform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GamePlatformRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).RemoveGamePlatform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_RemoveGamePlatform_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).RemoveGamePlatform(ctx, req.(*GamePlatformRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_AddGameRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGameRelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).AddGameRelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_AddGameRelation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).AddGameRelation(ctx, req.(*AddGameRelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_RemoveGameRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGameRelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).RemoveGameRelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_RemoveGameRelation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).RemoveGameRelation(ctx, req.(*RemoveGameRelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListGameTranslations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGameTranslationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListGameTranslations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListGameTranslations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListGameTranslations(ctx, req.(*ListGameTranslationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_SetGameTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGameTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).SetGameTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_SetGameTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).SetGameTranslation(ctx, req.(*SetGameTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_DeleteGameTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGameTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).DeleteGameTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_DeleteGameTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).DeleteGameTranslation(ctx, req.(*DeleteGameTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListGameMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGameMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListGameMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListGameMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListGameMedia(ctx, req.(*ListGameMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_AddGameMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGameMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).AddGameMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_AddGameMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).AddGameMedia(ctx, req.(*AddGameMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ReorderGameMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderGameMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ReorderGameMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ReorderGameMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ReorderGameMedia(ctx, req.(*ReorderGameMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_RemoveGameMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGameMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).RemoveGameMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_RemoveGameMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).RemoveGameMedia(ctx, req.(*RemoveGameMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_VoteGameTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteGameTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).VoteGameTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_VoteGameTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).VoteGameTag(ctx, req.(*VoteGameTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_RemoveGameTagVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGameTagVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).RemoveGameTagVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_RemoveGameTagVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).RemoveGameTagVote(ctx, req.(*RemoveGameTagVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_CreateGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGenreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).CreateGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_CreateGenre_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).CreateGenre(ctx, req.(*CreateGenreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetAllGenres_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetAllGenres(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetAllGenres_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetAllGenres(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGenreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetGenre_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetGenre(ctx, req.(*GetGenreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_UpdateGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGenreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).UpdateGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_UpdateGenre_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).UpdateGenre(ctx, req.(*UpdateGenreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_DeleteGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGenreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).DeleteGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_DeleteGenre_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).DeleteGenre(ctx, req.(*DeleteGenreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_MergeGenres_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeGenresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).MergeGenres(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_MergeGenres_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).MergeGenres(ctx, req.(*MergeGenresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_CreatePlatform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlatformRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).CreatePlatform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_CreatePlatform_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).CreatePlatform(ctx, req.(*CreatePlatformRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetAllPlatforms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetAllPlatforms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetAllPlatforms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetAllPlatforms(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetPlatform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlatformRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetPlatform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetPlatform_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetPlatform(ctx, req.(*GetPlatformRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_UpdatePlatform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePlatformRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).UpdatePlatform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_UpdatePlatform_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).UpdatePlatform(ctx, req.(*UpdatePlatformRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_DeletePlatform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlatformRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).DeletePlatform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_DeletePlatform_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).DeletePlatform(ctx, req.(*DeletePlatformRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_MergePlatforms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergePlatformsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).MergePlatforms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_MergePlatforms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).MergePlatforms(ctx, req.(*MergePlatformsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_CreateCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).CreateCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_CreateCompany_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).CreateCompany(ctx, req.(*CreateCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetAllCompanies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetAllCompanies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetAllCompanies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetAllCompanies(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetCompany_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetCompany(ctx, req.(*GetCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_UpdateCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).UpdateCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_UpdateCompany_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).UpdateCompany(ctx, req.(*UpdateCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_DeleteCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).DeleteCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_DeleteCompany_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).DeleteCompany(ctx, req.(*DeleteCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_CreateFranchise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFranchiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).CreateFranchise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_CreateFranchise_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).CreateFranchise(ctx, req.(*CreateFranchiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetAllFranchises_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetAllFranchises(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetAllFranchises_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetAllFranchises(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetFranchise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFranchiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetFranchise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetFranchise_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetFranchise(ctx, req.(*GetFranchiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_UpdateFranchise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFranchiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).UpdateFranchise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_UpdateFranchise_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).UpdateFranchise(ctx, req.(*UpdateFranchiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_DeleteFranchise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFranchiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).DeleteFranchise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_DeleteFranchise_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).DeleteFranchise(ctx, req.(*DeleteFranchiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetTags(ctx, req.(*GetTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_SuggestTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).SuggestTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_SuggestTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).SuggestTag(ctx, req.(*SuggestTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ApproveTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ApproveTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ApproveTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ApproveTag(ctx, req.(*ModerateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_RejectTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).RejectTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_RejectTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).RejectTag(ctx, req.(*ModerateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CatalogService_ServiceDesc is the grpc.ServiceDesc for CatalogService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CatalogService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "catalog.CatalogService",
+	HandlerType: (*CatalogServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGame",
+			Handler:    _CatalogService_CreateGame_Handler,
+		},
+		{
+			MethodName: "GetGame",
+			Handler:    _CatalogService_GetGame_Handler,
+		},
+		{
+			MethodName: "GetGameByExternalID",
+			Handler:    _CatalogService_GetGameByExternalID_Handler,
+		},
+		{
+			MethodName: "UpdateGame",
+			Handler:    _CatalogService_UpdateGame_Handler,
+		},
+		{
+			MethodName: "DeleteGame",
+			Handler:    _CatalogService_DeleteGame_Handler,
+		},
+		{
+			MethodName: "RestoreGame",
+			Handler:    _CatalogService_RestoreGame_Handler,
+		},
+		{
+			MethodName: "ListGames",
+			Handler:    _CatalogService_ListGames_Handler,
+		},
+		{
+			MethodName: "AutocompleteTitles",
+			Handler:    _CatalogService_AutocompleteTitles_Handler,
+		},
+		{
+			MethodName: "AddGameGenre",
+			Handler:    _CatalogService_AddGameGenre_Handler,
+		},
+		{
+			MethodName: "RemoveGameGenre",
+			Handler:    _CatalogService_RemoveGameGenre_Handler,
+		},
+		{
+			MethodName: "AddGamePlatform",
+			Handler:    _CatalogService_AddGamePlatform_Handler,
+		},
+		{
+			MethodName: "RemoveGamePlatform",
+			Handler:    _CatalogService_RemoveGamePlatform_Handler,
+		},
+		{
+			MethodName: "AddGameRelation",
+			Handler:    _CatalogService_AddGameRelation_Handler,
+		},
+		{
+			MethodName: "RemoveGameRelation",
+			Handler:    _CatalogService_RemoveGameRelation_Handler,
+		},
+		{
+			MethodName: "ListGameTranslations",
+			Handler:    _CatalogService_ListGameTranslations_Handler,
+		},
+		{
+			MethodName: "SetGameTranslation",
+			Handler:    _CatalogService_SetGameTranslation_Handler,
+		},
+		{
+			MethodName: "DeleteGameTranslation",
+			Handler:    _CatalogService_DeleteGameTranslation_Handler,
+		},
+		{
+			MethodName: "ListGameMedia",
+			Handler:    _CatalogService_ListGameMedia_Handler,
+		},
+		{
+			MethodName: "AddGameMedia",
+			Handler:    _CatalogService_AddGameMedia_Handler,
+		},
+		{
+			MethodName: "ReorderGameMedia",
+			Handler:    _CatalogService_ReorderGameMedia_Handler,
+		},
+		{
+			MethodName: "RemoveGameMedia",
+			Handler:    _CatalogService_RemoveGameMedia_Handler,
+		},
+		{
+			MethodName: "VoteGameTag",
+			Handler:    _CatalogService_VoteGameTag_Handler,
+		},
+		{
+			MethodName: "RemoveGameTagVote",
+			Handler:    _CatalogService_RemoveGameTagVote_Handler,
+		},
+		{
+			MethodName: "CreateGenre",
+			Handler:    _CatalogService_CreateGenre_Handler,
+		},
+		{
+			MethodName: "GetAllGenres",
+			Handler:    _CatalogService_GetAllGenres_Handler,
+		},
+		{
+			MethodName: "GetGenre",
+			Handler:    _CatalogService_GetGenre_Handler,
+		},
+		{
+			MethodName: "UpdateGenre",
+			Handler:    _CatalogService_UpdateGenre_Handler,
+		},
+		{
+			MethodName: "DeleteGenre",
+			Handler:    _CatalogService_DeleteGenre_Handler,
+		},
+		{
+			MethodName: "MergeGenres",
+			Handler:    _CatalogService_MergeGenres_Handler,
+		},
+		{
+			MethodName: "CreatePlatform",
+			Handler:    _CatalogService_CreatePlatform_Handler,
+		},
+		{
+			MethodName: "GetAllPlatforms",
+			Handler:    _CatalogService_GetAllPlatforms_Handler,
+		},
+		{
+			MethodName: "GetPlatform",
+			Handler:    _CatalogService_GetPlatform_Handler,
+		},
+		{
+			MethodName: "UpdatePlatform",
+			Handler:    _CatalogService_UpdatePlatform_Handler,
+		},
+		{
+			MethodName: "DeletePlatform",
+			Handler:    _CatalogService_DeletePlatform_Handler,
+		},
+		{
+			MethodName: "MergePlatforms",
+			Handler:    _CatalogService_MergePlatforms_Handler,
+		},
+		{
+			MethodName: "CreateCompany",
+			Handler:    _CatalogService_CreateCompany_Handler,
+		},
+		{
+			MethodName: "GetAllCompanies",
+			Handler:    _CatalogService_GetAllCompanies_Handler,
+		},
+		{
+			MethodName: "GetCompany",
+			Handler:    _CatalogService_GetCompany_Handler,
+		},
+		{
+			MethodName: "UpdateCompany",
+			Handler:    _CatalogService_UpdateCompany_Handler,
+		},
+		{
+			MethodName: "DeleteCompany",
+			Handler:    _CatalogService_DeleteCompany_Handler,
+		},
+		{
+			MethodName: "CreateFranchise",
+			Handler:    _CatalogService_CreateFranchise_Handler,
+		},
+		{
+			MethodName: "GetAllFranchises",
+			Handler:    _CatalogService_GetAllFranchises_Handler,
+		},
+		{
+			MethodName: "GetFranchise",
+			Handler:    _CatalogService_GetFranchise_Handler,
+		},
+		{
+			MethodName: "UpdateFranchise",
+			Handler:    _CatalogService_UpdateFranchise_Handler,
+		},
+		{
+			MethodName: "DeleteFranchise",
+			Handler:    _CatalogService_DeleteFranchise_Handler,
+		},
+		{
+			MethodName: "GetTags",
+			Handler:    _CatalogService_GetTags_Handler,
+		},
+		{
+			MethodName: "SuggestTag",
+			Handler:    _CatalogService_SuggestTag_Handler,
+		},
+		{
+			MethodName: "ApproveTag",
+			Handler:    _CatalogService_ApproveTag_Handler,
+		},
+		{
+			MethodName: "RejectTag",
+			Handler:    _CatalogService_RejectTag_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "catalog.proto",
+}
@@ -3,22 +3,25 @@ package main
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	catalogGRPC "github.com/NNNACHID/api-game-catalog-cl/internal/delivery/grpc"
 	catalogHTTP "github.com/NNNACHID/api-game-catalog-cl/internal/delivery/http"
-	"github.com/NNNACHID/api-game-catalog-cl/internal/catalog/repository"
-	"github.com/NNNACHID/api-game-catalog-cl/internal/catalog/service"
 	"github.com/NNNACHID/api-game-catalog-cl/internal/pkg/config"
 	"github.com/NNNACHID/api-game-catalog-cl/internal/pkg/migrations"
+	"github.com/NNNACHID/api-game-catalog-cl/internal/repository"
+	"github.com/NNNACHID/api-game-catalog-cl/internal/service"
 	"github.com/NNNACHID/api-game-catalog-cl/pkg/database"
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"google.golang.org/grpc"
 )
 
 func main() {
@@ -41,14 +44,30 @@ func main() {
 		logger.WithError(err).Fatal("Erreur lors des migrations")
 	}
 
+	err = migrations.MigrateCompanies(db, logger)
+	if err != nil {
+		logger.WithError(err).Fatal("Erreur lors de la migration des sociétés")
+	}
+
+	err = migrations.SetupFullTextSearch(db, cfg.Search.Language, logger)
+	if err != nil {
+		logger.WithError(err).Fatal("Erreur lors de la mise en place de la recherche plein texte")
+	}
+
+	err = migrations.SetupFuzzySearch(db, logger)
+	if err != nil {
+		logger.WithError(err).Fatal("Erreur lors de la mise en place de la recherche approximative")
+	}
+
 	err = migrations.SeedData(db, logger)
 	if err != nil {
 		logger.WithError(err).Fatal("Erreur lors de l'initialisation des données de test")
 	}
 
-	gameRepo := repository.NewPostgresGameRepository(db)
-	gameService := service.NewGameService(gameRepo, logger)
+	gameRepo := repository.NewPostgresGameRepository(db, cfg.Search.Language)
+	gameService := service.NewGameService(gameRepo, logger, cfg.Locale.Default)
 	gameHandler := catalogHTTP.NewGameHandler(gameService, logger)
+	gameServer := catalogGRPC.NewGameServer(gameService, logger)
 
 	router := gin.New()
 	router.Use(gin.Recovery())
@@ -92,12 +111,30 @@ func main() {
 		}
 	}()
 
+	grpcServer := grpc.NewServer()
+	gameServer.RegisterServer(grpcServer)
+
+	listener, err := net.Listen("tcp", ":"+cfg.GRPC.Port)
+	if err != nil {
+		logger.WithError(err).Fatal("Impossible d'ouvrir le port gRPC")
+	}
+
+	go func() {
+		logger.Infof("Serveur gRPC démarré sur le port %s", cfg.GRPC.Port)
+		err := grpcServer.Serve(listener)
+		if err != nil {
+			logger.WithError(err).Fatal("Erreur lors du démarrage du serveur gRPC")
+		}
+	}()
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
 	logger.Info("Arrêt du serveur en cours...")
 
+	grpcServer.GracefulStop()
+
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
@@ -0,0 +1,699 @@
+package grpc
+
+import (
+	"time"
+
+	pb "github.com/NNNACHID/api-game-catalog-cl/api/proto/catalog"
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func toProtoGame(game *models.Game) *pb.Game {
+	protoGame := &pb.Game{
+		Id:                 uint32(game.ID),
+		Title:              game.Title,
+		Description:        game.Description,
+		Developer:          game.Developer,
+		Publisher:          game.Publisher,
+		ReleaseDate:        toProtoTimestamp(game.ReleaseDate),
+		Genres:             toProtoGenres(game.Genres),
+		Platforms:          toProtoPlatforms(game.Platforms),
+		Developers:         toProtoCompanies(game.Developers),
+		Publishers:         toProtoCompanies(game.Publishers),
+		ImageUrl:           game.ImageURL,
+		AverageRating:      game.AverageRating,
+		Price:              game.Price.InexactFloat64(),
+		Currency:           game.Currency,
+		Prices:             toProtoPrices(game.Prices),
+		Releases:           toProtoReleases(game.Releases),
+		AgeRatings:         toProtoAgeRatings(game.AgeRatings),
+		Locale:             game.Locale,
+		Translations:       toProtoTranslations(game.Translations),
+		Media:              toProtoMedia(game.Media),
+		SystemRequirements: toProtoSystemRequirements(game.SystemRequirements),
+		Tags:               toProtoGameTags(game.Tags),
+		Languages:          toProtoLanguages(game.Languages),
+		GameModes:          game.GameModes,
+		MinPlayers:         int32(game.MinPlayers),
+		MaxPlayers:         int32(game.MaxPlayers),
+		Accessibility:      game.Accessibility,
+		ExternalIds:        toProtoExternalIDs(game.ExternalIDs),
+		Uuid:               game.UUID,
+		Slug:               game.Slug,
+		CreatedAt:          toProtoTimestamp(game.CreatedAt),
+		UpdatedAt:          toProtoTimestamp(game.UpdatedAt),
+
+		Relevance:            game.Relevance,
+		TitleHighlight:       game.TitleHighlight,
+		DescriptionHighlight: game.DescriptionHighlight,
+	}
+	if game.DeletedAt.Valid {
+		protoGame.DeletedAt = toProtoTimestamp(game.DeletedAt.Time)
+	}
+	if game.FranchiseID != nil {
+		franchiseID := uint32(*game.FranchiseID)
+		protoGame.FranchiseId = &franchiseID
+	}
+	if game.Franchise != nil {
+		protoGame.Franchise = toProtoFranchise(*game.Franchise)
+	}
+	if game.Related != nil {
+		protoGame.Related = toProtoRelatedGames(game.Related)
+	}
+
+	return protoGame
+}
+
+func toProtoPrices(prices []models.GamePrice) []*pb.Price {
+	result := make([]*pb.Price, 0, len(prices))
+	for _, price := range prices {
+		protoPrice := &pb.Price{
+			Region:   price.Region,
+			Amount:   price.Amount.StringFixed(2),
+			Currency: price.Currency,
+		}
+		if price.PlatformID != nil {
+			protoPrice.PlatformId = uint32(*price.PlatformID)
+		}
+		result = append(result, protoPrice)
+	}
+
+	return result
+}
+
+func pricesFromProto(prices []*pb.Price) ([]models.GamePrice, error) {
+	result := make([]models.GamePrice, 0, len(prices))
+	for _, price := range prices {
+		amount, err := decimal.NewFromString(price.GetAmount())
+		if err != nil {
+			return nil, err
+		}
+
+		gamePrice := models.GamePrice{
+			Region:   price.GetRegion(),
+			Amount:   amount,
+			Currency: price.GetCurrency(),
+		}
+		if price.GetPlatformId() != 0 {
+			platformID := uint(price.GetPlatformId())
+			gamePrice.PlatformID = &platformID
+		}
+		result = append(result, gamePrice)
+	}
+
+	return result, nil
+}
+
+func toProtoReleases(releases []models.GameRelease) []*pb.Release {
+	result := make([]*pb.Release, 0, len(releases))
+	for _, release := range releases {
+		protoRelease := &pb.Release{
+			Region:    release.Region,
+			Precision: release.Precision,
+			Status:    release.Status,
+		}
+		if release.PlatformID != nil {
+			protoRelease.PlatformId = uint32(*release.PlatformID)
+		}
+		if release.Date != nil {
+			protoRelease.Date = toProtoTimestamp(*release.Date)
+		}
+		result = append(result, protoRelease)
+	}
+
+	return result
+}
+
+func toProtoTranslation(translation models.GameTranslation) *pb.GameTranslation {
+	return &pb.GameTranslation{
+		GameId:      uint32(translation.GameID),
+		Locale:      translation.Locale,
+		Title:       translation.Title,
+		Description: translation.Description,
+		CreatedAt:   toProtoTimestamp(translation.CreatedAt),
+		UpdatedAt:   toProtoTimestamp(translation.UpdatedAt),
+	}
+}
+
+func toProtoTranslations(translations []models.GameTranslation) []*pb.GameTranslation {
+	result := make([]*pb.GameTranslation, 0, len(translations))
+	for _, translation := range translations {
+		result = append(result, toProtoTranslation(translation))
+	}
+
+	return result
+}
+
+func toProtoGameMedia(media models.GameMedia) *pb.GameMedia {
+	return &pb.GameMedia{
+		Id:       uint32(media.ID),
+		GameId:   uint32(media.GameID),
+		Type:     media.Type,
+		Url:      media.URL,
+		Caption:  media.Caption,
+		Width:    int32(media.Width),
+		Height:   int32(media.Height),
+		Position: int32(media.Position),
+		Primary:  media.Primary,
+	}
+}
+
+func toProtoMedia(media []models.GameMedia) []*pb.GameMedia {
+	result := make([]*pb.GameMedia, 0, len(media))
+	for _, item := range media {
+		result = append(result, toProtoGameMedia(item))
+	}
+
+	return result
+}
+
+func toProtoSystemRequirements(requirements []models.SystemRequirements) []*pb.SystemRequirements {
+	result := make([]*pb.SystemRequirements, 0, len(requirements))
+	for _, requirement := range requirements {
+		result = append(result, &pb.SystemRequirements{
+			PlatformId:     uint32(requirement.PlatformID),
+			Tier:           requirement.Tier,
+			Os:             requirement.OS,
+			Cpu:            requirement.CPU,
+			Gpu:            requirement.GPU,
+			MemoryMb:       int32(requirement.MemoryMB),
+			StorageMb:      int32(requirement.StorageMB),
+			DirectxVersion: requirement.DirectXVersion,
+		})
+	}
+
+	return result
+}
+
+func systemRequirementsFromProto(requirements []*pb.SystemRequirements) []models.SystemRequirements {
+	result := make([]models.SystemRequirements, 0, len(requirements))
+	for _, requirement := range requirements {
+		result = append(result, models.SystemRequirements{
+			PlatformID:     uint(requirement.GetPlatformId()),
+			Tier:           requirement.GetTier(),
+			OS:             requirement.GetOs(),
+			CPU:            requirement.GetCpu(),
+			GPU:            requirement.GetGpu(),
+			MemoryMB:       int(requirement.GetMemoryMb()),
+			StorageMB:      int(requirement.GetStorageMb()),
+			DirectXVersion: requirement.GetDirectxVersion(),
+		})
+	}
+
+	return result
+}
+
+func toProtoTag(tag models.Tag) *pb.Tag {
+	return &pb.Tag{
+		Id:        uint32(tag.ID),
+		Name:      tag.Name,
+		Status:    tag.Status,
+		GameCount: tag.GameCount,
+		Votes:     tag.Votes,
+	}
+}
+
+func toProtoTags(tags []models.Tag) []*pb.Tag {
+	result := make([]*pb.Tag, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, toProtoTag(tag))
+	}
+
+	return result
+}
+
+func toProtoGameTag(gameTag models.GameTag) *pb.GameTag {
+	protoTag := &pb.GameTag{
+		TagId: uint32(gameTag.TagID),
+		Votes: int32(gameTag.Votes),
+	}
+	if gameTag.Tag != nil {
+		protoTag.Tag = toProtoTag(*gameTag.Tag)
+	}
+
+	return protoTag
+}
+
+func toProtoGameTags(gameTags []models.GameTag) []*pb.GameTag {
+	result := make([]*pb.GameTag, 0, len(gameTags))
+	for _, gameTag := range gameTags {
+		result = append(result, toProtoGameTag(gameTag))
+	}
+
+	return result
+}
+
+func toProtoLanguages(languages []models.GameLanguage) []*pb.GameLanguage {
+	result := make([]*pb.GameLanguage, 0, len(languages))
+	for _, language := range languages {
+		result = append(result, &pb.GameLanguage{
+			Language:  language.Language,
+			Interface: language.Interface,
+			Audio:     language.Audio,
+			Subtitles: language.Subtitles,
+		})
+	}
+
+	return result
+}
+
+func languagesFromProto(languages []*pb.GameLanguage) []models.GameLanguage {
+	result := make([]models.GameLanguage, 0, len(languages))
+	for _, language := range languages {
+		result = append(result, models.GameLanguage{
+			Language:  language.GetLanguage(),
+			Interface: language.GetInterface(),
+			Audio:     language.GetAudio(),
+			Subtitles: language.GetSubtitles(),
+		})
+	}
+
+	return result
+}
+
+func toProtoExternalIDs(ids []models.GameExternalID) []*pb.ExternalID {
+	result := make([]*pb.ExternalID, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, &pb.ExternalID{
+			Provider:   id.Provider,
+			ExternalId: id.ExternalID,
+		})
+	}
+
+	return result
+}
+
+func externalIDsFromProto(ids []*pb.ExternalID) []models.GameExternalID {
+	result := make([]models.GameExternalID, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, models.GameExternalID{
+			Provider:   id.GetProvider(),
+			ExternalID: id.GetExternalId(),
+		})
+	}
+
+	return result
+}
+
+func toProtoAgeRatings(ratings []models.AgeRating) []*pb.AgeRating {
+	result := make([]*pb.AgeRating, 0, len(ratings))
+	for _, rating := range ratings {
+		result = append(result, &pb.AgeRating{
+			Board:       rating.Board,
+			Rating:      rating.Rating,
+			MinimumAge:  int32(rating.MinimumAge),
+			Descriptors: rating.Descriptors,
+		})
+	}
+
+	return result
+}
+
+func ageRatingsFromProto(ratings []*pb.AgeRating) []models.AgeRating {
+	result := make([]models.AgeRating, 0, len(ratings))
+	for _, rating := range ratings {
+		result = append(result, models.AgeRating{
+			Board:       rating.GetBoard(),
+			Rating:      rating.GetRating(),
+			Descriptors: rating.GetDescriptors(),
+		})
+	}
+
+	return result
+}
+
+func releasesFromProto(releases []*pb.Release) []models.GameRelease {
+	result := make([]models.GameRelease, 0, len(releases))
+	for _, release := range releases {
+		gameRelease := models.GameRelease{
+			Region:    release.GetRegion(),
+			Precision: release.GetPrecision(),
+			Status:    release.GetStatus(),
+		}
+		if release.GetPlatformId() != 0 {
+			platformID := uint(release.GetPlatformId())
+			gameRelease.PlatformID = &platformID
+		}
+		if release.GetDate() != nil {
+			date := fromProtoTimestamp(release.GetDate())
+			gameRelease.Date = &date
+		}
+		result = append(result, gameRelease)
+	}
+
+	return result
+}
+
+func toProtoGenre(genre models.Genre) *pb.Genre {
+	return &pb.Genre{
+		Id:   uint32(genre.ID),
+		Name: genre.Name,
+	}
+}
+
+func toProtoGenres(genres []models.Genre) []*pb.Genre {
+	result := make([]*pb.Genre, 0, len(genres))
+	for _, genre := range genres {
+		result = append(result, toProtoGenre(genre))
+	}
+
+	return result
+}
+
+func toProtoPlatform(platform models.Platform) *pb.Platform {
+	return &pb.Platform{
+		Id:   uint32(platform.ID),
+		Name: platform.Name,
+	}
+}
+
+func toProtoPlatforms(platforms []models.Platform) []*pb.Platform {
+	result := make([]*pb.Platform, 0, len(platforms))
+	for _, platform := range platforms {
+		result = append(result, toProtoPlatform(platform))
+	}
+
+	return result
+}
+
+func toProtoCompany(company models.Company) *pb.Company {
+	return &pb.Company{
+		Id:      uint32(company.ID),
+		Name:    company.Name,
+		Website: company.Website,
+	}
+}
+
+func toProtoCompanies(companies []models.Company) []*pb.Company {
+	result := make([]*pb.Company, 0, len(companies))
+	for _, company := range companies {
+		result = append(result, toProtoCompany(company))
+	}
+
+	return result
+}
+
+func toProtoFranchise(franchise models.Franchise) *pb.Franchise {
+	return &pb.Franchise{
+		Id:          uint32(franchise.ID),
+		Name:        franchise.Name,
+		Description: franchise.Description,
+	}
+}
+
+func toProtoFranchises(franchises []models.Franchise) []*pb.Franchise {
+	result := make([]*pb.Franchise, 0, len(franchises))
+	for _, franchise := range franchises {
+		result = append(result, toProtoFranchise(franchise))
+	}
+
+	return result
+}
+
+func toProtoGameRelation(relation *models.GameRelation) *pb.GameRelation {
+	protoRelation := &pb.GameRelation{
+		Id:            uint32(relation.ID),
+		GameId:        uint32(relation.GameID),
+		RelatedGameId: uint32(relation.RelatedGameID),
+		Type:          relation.Type,
+	}
+	if relation.Game != nil {
+		protoRelation.Game = toProtoGame(relation.Game)
+	}
+	if relation.RelatedGame != nil {
+		protoRelation.RelatedGame = toProtoGame(relation.RelatedGame)
+	}
+
+	return protoRelation
+}
+
+func toProtoGameRelations(relations []models.GameRelation) []*pb.GameRelation {
+	result := make([]*pb.GameRelation, 0, len(relations))
+	for i := range relations {
+		result = append(result, toProtoGameRelation(&relations[i]))
+	}
+
+	return result
+}
+
+func toProtoRelatedGames(related *models.RelatedGames) *pb.RelatedGames {
+	series := make([]*pb.Game, 0, len(related.Series))
+	for i := range related.Series {
+		series = append(series, toProtoGame(&related.Series[i]))
+	}
+
+	return &pb.RelatedGames{
+		Series:           series,
+		Relations:        toProtoGameRelations(related.Relations),
+		InverseRelations: toProtoGameRelations(related.InverseRelations),
+	}
+}
+
+func toProtoListGamesResponse(response *models.GameResponse) *pb.ListGamesResponse {
+	games := make([]*pb.Game, 0, len(response.Games))
+	for i := range response.Games {
+		games = append(games, toProtoGame(&response.Games[i]))
+	}
+
+	return &pb.ListGamesResponse{
+		Games:      games,
+		TotalCount: response.TotalCount,
+		Page:       int32(response.Page),
+		PageSize:   int32(response.PageSize),
+		TotalPages: int32(response.TotalPages),
+		NextCursor: response.NextCursor,
+		Facets:     toProtoGameFacets(response.Facets),
+	}
+}
+
+func toProtoGameFacets(facets *models.GameFacets) *pb.GameFacets {
+	if facets == nil {
+		return nil
+	}
+
+	return &pb.GameFacets{
+		Genres:     toProtoFacetCounts(facets.Genres),
+		Platforms:  toProtoFacetCounts(facets.Platforms),
+		Developers: toProtoFacetCounts(facets.Developers),
+		Publishers: toProtoFacetCounts(facets.Publishers),
+		Ratings:    toProtoFacetCounts(facets.Ratings),
+		Tags:       toProtoFacetCounts(facets.Tags),
+	}
+}
+
+func toProtoFacetCounts(counts []models.FacetCount) []*pb.FacetCount {
+	result := make([]*pb.FacetCount, 0, len(counts))
+	for _, count := range counts {
+		result = append(result, &pb.FacetCount{
+			Id:    uint32(count.ID),
+			Value: count.Value,
+			Count: count.Count,
+		})
+	}
+
+	return result
+}
+
+func toProtoTitleSuggestions(suggestions []models.TitleSuggestion) []*pb.TitleSuggestion {
+	result := make([]*pb.TitleSuggestion, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		result = append(result, &pb.TitleSuggestion{
+			Id:         uint32(suggestion.ID),
+			Title:      suggestion.Title,
+			Similarity: suggestion.Similarity,
+		})
+	}
+
+	return result
+}
+
+func gameFromCreateRequest(req *pb.CreateGameRequest) (*models.Game, error) {
+	prices, err := pricesFromProto(req.GetPrices())
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Game{
+		Title:              req.GetTitle(),
+		Description:        req.GetDescription(),
+		Developer:          req.GetDeveloper(),
+		Publisher:          req.GetPublisher(),
+		ReleaseDate:        fromProtoTimestamp(req.GetReleaseDate()),
+		GenreIDs:           uintsFromProto(req.GetGenreIds()),
+		PlatformIDs:        uintsFromProto(req.GetPlatformIds()),
+		DeveloperIDs:       uintsFromProto(req.GetDeveloperIds()),
+		PublisherIDs:       uintsFromProto(req.GetPublisherIds()),
+		FranchiseID:        optionalUint(req.FranchiseId),
+		Releases:           releasesFromProto(req.GetReleases()),
+		AgeRatings:         ageRatingsFromProto(req.GetAgeRatings()),
+		SystemRequirements: systemRequirementsFromProto(req.GetSystemRequirements()),
+		Languages:          languagesFromProto(req.GetLanguages()),
+		GameModes:          req.GetGameModes(),
+		MinPlayers:         int(req.GetMinPlayers()),
+		MaxPlayers:         int(req.GetMaxPlayers()),
+		Accessibility:      req.GetAccessibility(),
+		ExternalIDs:        externalIDsFromProto(req.GetExternalIds()),
+		ImageURL:           req.GetImageUrl(),
+		AverageRating:      req.GetAverageRating(),
+		Price:              decimal.NewFromFloat(req.GetPrice()).Round(2),
+		Currency:           req.GetCurrency(),
+		Prices:             prices,
+	}, nil
+}
+
+func gameFromUpdateRequest(req *pb.UpdateGameRequest) (*models.Game, error) {
+	prices, err := pricesFromProto(req.GetPrices())
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Game{
+		ID:                 uint(req.GetId()),
+		Title:              req.GetTitle(),
+		Description:        req.GetDescription(),
+		Developer:          req.GetDeveloper(),
+		Publisher:          req.GetPublisher(),
+		ReleaseDate:        fromProtoTimestamp(req.GetReleaseDate()),
+		GenreIDs:           uintsFromProto(req.GetGenreIds()),
+		PlatformIDs:        uintsFromProto(req.GetPlatformIds()),
+		DeveloperIDs:       uintsFromProto(req.GetDeveloperIds()),
+		PublisherIDs:       uintsFromProto(req.GetPublisherIds()),
+		FranchiseID:        optionalUint(req.FranchiseId),
+		Releases:           releasesFromProto(req.GetReleases()),
+		AgeRatings:         ageRatingsFromProto(req.GetAgeRatings()),
+		SystemRequirements: systemRequirementsFromProto(req.GetSystemRequirements()),
+		Languages:          languagesFromProto(req.GetLanguages()),
+		GameModes:          req.GetGameModes(),
+		MinPlayers:         int(req.GetMinPlayers()),
+		MaxPlayers:         int(req.GetMaxPlayers()),
+		Accessibility:      req.GetAccessibility(),
+		ExternalIDs:        externalIDsFromProto(req.GetExternalIds()),
+		ImageURL:           req.GetImageUrl(),
+		AverageRating:      req.GetAverageRating(),
+		Price:              decimal.NewFromFloat(req.GetPrice()).Round(2),
+		Currency:           req.GetCurrency(),
+		Prices:             prices,
+	}, nil
+}
+
+func filterFromListRequest(req *pb.ListGamesRequest) *models.GameFilter {
+	filter := &models.GameFilter{
+		Query:              req.GetQ(),
+		Fuzzy:              req.GetFuzzy(),
+		Title:              req.GetTitle(),
+		Developer:          req.GetDeveloper(),
+		Publisher:          req.GetPublisher(),
+		Genres:             req.GetGenres(),
+		GenreIDs:           uintsFromProto(req.GetGenreIds()),
+		GenreMatch:         req.GetGenreMatch(),
+		Tags:               req.GetTags(),
+		TagMatch:           req.GetTagMatch(),
+		InterfaceLanguages: req.GetInterfaceLanguages(),
+		AudioLanguages:     req.GetAudioLanguages(),
+		SubtitleLanguages:  req.GetSubtitleLanguages(),
+		GameModes:          req.GetGameModes(),
+		Accessibility:      req.GetAccessibility(),
+		MissingExternalID:  req.GetMissingExternalId(),
+		ExcludeGenres:      req.GetExcludeGenres(),
+		ExcludeGenreIDs:    uintsFromProto(req.GetExcludeGenreIds()),
+		Platforms:          req.GetPlatforms(),
+		PlatformIDs:        uintsFromProto(req.GetPlatformIds()),
+		PlatformMatch:      req.GetPlatformMatch(),
+		ExcludePlatforms:   req.GetExcludePlatforms(),
+		ExcludePlatformIDs: uintsFromProto(req.GetExcludePlatformIds()),
+		DeveloperIDs:       uintsFromProto(req.GetDeveloperIds()),
+		PublisherIDs:       uintsFromProto(req.GetPublisherIds()),
+		CompanyIDs:         uintsFromProto(req.GetCompanyIds()),
+		ReleasePlatformID:  optionalUint(req.ReleasePlatformId),
+		ReleaseRegion:      req.GetReleaseRegion(),
+		Upcoming:           req.GetUpcoming(),
+		ReleaseStatus:      req.GetReleaseStatus(),
+		RatingBoard:        req.GetRatingBoard(),
+		Currency:           req.GetCurrency(),
+		IncludeDeleted:     req.GetIncludeDeleted(),
+		SortBy:             req.GetSortBy(),
+		SortOrder:          req.GetSortOrder(),
+		Page:               int(req.GetPage()),
+		PageSize:           int(req.GetPageSize()),
+		Cursor:             req.GetCursor(),
+		Facets:             req.GetFacets(),
+	}
+
+	if req.GetReleasedAfter() != nil {
+		releasedAfter := fromProtoTimestamp(req.GetReleasedAfter())
+		filter.ReleasedAfter = &releasedAfter
+	}
+	if req.GetReleasedBefore() != nil {
+		releasedBefore := fromProtoTimestamp(req.GetReleasedBefore())
+		filter.ReleasedBefore = &releasedBefore
+	}
+	if req.Players != nil {
+		players := int(req.GetPlayers())
+		filter.Players = &players
+	}
+	if req.MaxMemoryGb != nil {
+		maxMemory := int(req.GetMaxMemoryGb())
+		filter.MaxMemoryGB = &maxMemory
+	}
+	if req.MaxStorageGb != nil {
+		maxStorage := int(req.GetMaxStorageGb())
+		filter.MaxStorageGB = &maxStorage
+	}
+	if req.MaxAge != nil {
+		maxAge := int(req.GetMaxAge())
+		filter.MaxAge = &maxAge
+	}
+	if req.MinRating != nil {
+		minRating := req.GetMinRating()
+		filter.MinRating = &minRating
+	}
+	if req.MinPrice != nil {
+		minPrice := req.GetMinPrice()
+		filter.MinPrice = &minPrice
+	}
+	if req.MaxPrice != nil {
+		maxPrice := req.GetMaxPrice()
+		filter.MaxPrice = &maxPrice
+	}
+
+	return filter
+}
+
+func uintsFromProto(values []uint32) []uint {
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := make([]uint, 0, len(values))
+	for _, value := range values {
+		result = append(result, uint(value))
+	}
+
+	return result
+}
+
+func optionalUint(value *uint32) *uint {
+	if value == nil {
+		return nil
+	}
+
+	result := uint(*value)
+	return &result
+}
+
+func toProtoTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
+
+func fromProtoTimestamp(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+
+	return ts.AsTime()
+}
@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var grpcCodeByCode = map[apperrors.Code]codes.Code{
+	apperrors.CodeNotFound:   codes.NotFound,
+	apperrors.CodeConflict:   codes.AlreadyExists,
+	apperrors.CodeValidation: codes.InvalidArgument,
+	apperrors.CodeInternal:   codes.Internal,
+}
+
+func toStatusError(err error) error {
+	code, ok := grpcCodeByCode[apperrors.CodeOf(err)]
+	if !ok {
+		code = codes.Internal
+	}
+
+	st := status.New(code, apperrors.MessageOf(err))
+
+	fields := apperrors.FieldsOf(err)
+	if len(fields) == 0 {
+		return st.Err()
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	for _, field := range fields {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field.Field,
+			Description: field.Message,
+		})
+	}
+
+	detailed, detailErr := st.WithDetails(badRequest)
+	if detailErr != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+func invalidPrice(err error) error {
+	return apperrors.Wrap(apperrors.CodeValidation, err, "invalid price amount")
+}
@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatusError(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		code    codes.Code
+		message string
+	}{
+		{"introuvable", apperrors.NotFound("game not found"), codes.NotFound, "game not found"},
+		{"introuvable enveloppé", fmt.Errorf("loading: %w", apperrors.NotFound("game not found")), codes.NotFound, "game not found"},
+		{"conflit", apperrors.Conflict("genre already exists"), codes.AlreadyExists, "genre already exists"},
+		{"validation", apperrors.Validation("invalid cursor"), codes.InvalidArgument, "invalid cursor"},
+		{"erreur interne masquée", apperrors.Internal(errors.New("pq: password authentication failed")), codes.Internal, apperrors.ErrInternal.Message},
+		{"erreur non typée masquée", errors.New("dial tcp 10.0.0.3:5432"), codes.Internal, apperrors.ErrInternal.Message},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, ok := status.FromError(toStatusError(tt.err))
+
+			require.True(t, ok)
+			assert.Equal(t, tt.code, st.Code())
+			assert.Equal(t, tt.message, st.Message())
+			assert.Empty(t, st.Details())
+		})
+	}
+}
+
+func TestToStatusErrorFieldViolations(t *testing.T) {
+	err := apperrors.InvalidFields("Validation failed",
+		apperrors.FieldError{Field: "title", Message: "le titre du jeu est obligatoire"},
+		apperrors.FieldError{Field: "genre_ids[1]", Message: "le genre 9 n'existe pas"},
+	)
+
+	st, ok := status.FromError(toStatusError(err))
+
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.GetFieldViolations(), 2)
+	assert.Equal(t, "title", badRequest.GetFieldViolations()[0].GetField())
+	assert.Equal(t, "le titre du jeu est obligatoire", badRequest.GetFieldViolations()[0].GetDescription())
+	assert.Equal(t, "genre_ids[1]", badRequest.GetFieldViolations()[1].GetField())
+}
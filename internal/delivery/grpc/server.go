@@ -0,0 +1,656 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	pb "github.com/NNNACHID/api-game-catalog-cl/api/proto/catalog"
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+	"github.com/NNNACHID/api-game-catalog-cl/internal/service"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type GameServer struct {
+	pb.UnimplementedCatalogServiceServer
+	service service.GameService
+	logger  *logrus.Logger
+}
+
+func NewGameServer(service service.GameService, logger *logrus.Logger) *GameServer {
+	return &GameServer{
+		service: service,
+		logger:  logger,
+	}
+}
+
+func (s *GameServer) RegisterServer(server *grpc.Server) {
+	pb.RegisterCatalogServiceServer(server, s)
+}
+
+func (s *GameServer) CreateGame(ctx context.Context, req *pb.CreateGameRequest) (*pb.Game, error) {
+	game, err := gameFromCreateRequest(req)
+	if err != nil {
+		return nil, toStatusError(invalidPrice(err))
+	}
+
+	err = s.service.CreateGame(ctx, game)
+	if err != nil {
+		s.logger.WithError(err).Error("Error creating game")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoGame(game), nil
+}
+
+func (s *GameServer) GetGame(ctx context.Context, req *pb.GetGameRequest) (*pb.Game, error) {
+	var game *models.Game
+	var err error
+	if req.GetRef() != "" {
+		game, _, err = s.service.GetGameByRef(ctx, req.GetRef())
+	} else {
+		game, err = s.service.GetGameByID(ctx, uint(req.GetId()))
+	}
+	if err != nil {
+		s.logger.WithError(err).Error("Error retrieving game")
+		return nil, toStatusError(err)
+	}
+
+	for _, include := range req.GetInclude() {
+		switch include {
+		case "related":
+			game.Related, err = s.service.GetRelatedGames(ctx, game)
+			if err == nil {
+				err = s.service.LocalizeRelatedGames(ctx, game.Related, req.GetLanguages())
+			}
+		case "translations":
+			game.Translations, err = s.service.GetGameTranslations(ctx, game.ID)
+		case "media":
+			game.Media, err = s.service.GetGameMedia(ctx, game.ID)
+		default:
+			err = apperrors.InvalidFields("Invalid include", apperrors.FieldError{
+				Field:   "include",
+				Message: "unknown include " + include,
+			})
+		}
+		if err != nil {
+			s.logger.WithError(err).Error("Error retrieving game")
+			return nil, toStatusError(err)
+		}
+	}
+
+	games := []models.Game{*game}
+	err = s.service.LocalizeGames(ctx, games, req.GetLanguages())
+	if err != nil {
+		s.logger.WithError(err).Error("Error localizing game")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoGame(&games[0]), nil
+}
+
+func (s *GameServer) GetGameByExternalID(ctx context.Context, req *pb.GetGameByExternalIDRequest) (*pb.Game, error) {
+	game, err := s.service.GetGameByExternalID(ctx, req.GetProvider(), req.GetExternalId())
+	if err != nil {
+		s.logger.WithError(err).Error("Error retrieving game by external ID")
+		return nil, toStatusError(err)
+	}
+
+	games := []models.Game{*game}
+	err = s.service.LocalizeGames(ctx, games, req.GetLanguages())
+	if err != nil {
+		s.logger.WithError(err).Error("Error localizing game")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoGame(&games[0]), nil
+}
+
+func (s *GameServer) UpdateGame(ctx context.Context, req *pb.UpdateGameRequest) (*pb.Game, error) {
+	game, err := gameFromUpdateRequest(req)
+	if err != nil {
+		return nil, toStatusError(invalidPrice(err))
+	}
+
+	err = s.service.UpdateGame(ctx, game)
+	if err != nil {
+		s.logger.WithError(err).Error("Error updating the game")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoGame(game), nil
+}
+
+func (s *GameServer) DeleteGame(ctx context.Context, req *pb.DeleteGameRequest) (*emptypb.Empty, error) {
+	err := s.service.DeleteGame(ctx, uint(req.GetId()))
+	if err != nil {
+		s.logger.WithError(err).Error("Error deleting game")
+		return nil, toStatusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *GameServer) RestoreGame(ctx context.Context, req *pb.RestoreGameRequest) (*pb.Game, error) {
+	game, err := s.service.RestoreGame(ctx, uint(req.GetId()))
+	if err != nil {
+		s.logger.WithError(err).Error("Error restoring game")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoGame(game), nil
+}
+
+func (s *GameServer) ListGames(ctx context.Context, req *pb.ListGamesRequest) (*pb.ListGamesResponse, error) {
+	games, err := s.service.ListGames(ctx, filterFromListRequest(req))
+	if err != nil {
+		s.logger.WithError(err).Error("Error retrieving games")
+		return nil, toStatusError(err)
+	}
+
+	for _, include := range req.GetInclude() {
+		switch include {
+		case "media":
+			err = s.service.EmbedMedia(ctx, games.Games)
+		default:
+			err = apperrors.InvalidFields("Invalid include", apperrors.FieldError{
+				Field:   "include",
+				Message: "unknown include " + include,
+			})
+		}
+		if err != nil {
+			s.logger.WithError(err).Error("Error retrieving games")
+			return nil, toStatusError(err)
+		}
+	}
+
+	err = s.service.LocalizeGames(ctx, games.Games, req.GetLanguages())
+	if err != nil {
+		s.logger.WithError(err).Error("Error localizing games")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoListGamesResponse(games), nil
+}
+
+func (s *GameServer) AutocompleteTitles(ctx context.Context, req *pb.AutocompleteTitlesRequest) (*pb.AutocompleteTitlesResponse, error) {
+	suggestions, err := s.service.AutocompleteTitles(ctx, req.GetPrefix(), int(req.GetLimit()))
+	if err != nil {
+		s.logger.WithError(err).Error("Error autocompleting titles")
+		return nil, toStatusError(err)
+	}
+
+	return &pb.AutocompleteTitlesResponse{Suggestions: toProtoTitleSuggestions(suggestions)}, nil
+}
+
+func (s *GameServer) AddGameGenre(ctx context.Context, req *pb.GameGenreRequest) (*pb.Game, error) {
+	game, err := s.service.AddGenreToGame(ctx, uint(req.GetGameId()), uint(req.GetGenreId()))
+	if err != nil {
+		s.logger.WithError(err).Error("Error adding genre to game")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoGame(game), nil
+}
+
+func (s *GameServer) RemoveGameGenre(ctx context.Context, req *pb.GameGenreRequest) (*pb.Game, error) {
+	game, err := s.service.RemoveGenreFromGame(ctx, uint(req.GetGameId()), uint(req.GetGenreId()))
+	if err != nil {
+		s.logger.WithError(err).Error("Error removing genre from game")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoGame(game), nil
+}
+
+func (s *GameServer) AddGamePlatform(ctx context.Context, req *pb.GamePlatformRequest) (*pb.Game, error) {
+	game, err := s.service.AddPlatformToGame(ctx, uint(req.GetGameId()), uint(req.GetPlatformId()))
+	if err != nil {
+		s.logger.WithError(err).Error("Error adding platform to game")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoGame(game), nil
+}
+
+func (s *GameServer) RemoveGamePlatform(ctx context.Context, req *pb.GamePlatformRequest) (*pb.Game, error) {
+	game, err := s.service.RemovePlatformFromGame(ctx, uint(req.GetGameId()), uint(req.GetPlatformId()))
+	if err != nil {
+		s.logger.WithError(err).Error("Error removing platform from game")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoGame(game), nil
+}
+
+func (s *GameServer) AddGameRelation(ctx context.Context, req *pb.AddGameRelationRequest) (*pb.GameRelation, error) {
+	relation := &models.GameRelation{
+		GameID:        uint(req.GetGameId()),
+		RelatedGameID: uint(req.GetRelatedGameId()),
+		Type:          req.GetType(),
+	}
+
+	err := s.service.AddGameRelation(ctx, relation)
+	if err != nil {
+		s.logger.WithError(err).Error("Error adding game relation")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoGameRelation(relation), nil
+}
+
+func (s *GameServer) RemoveGameRelation(ctx context.Context, req *pb.RemoveGameRelationRequest) (*emptypb.Empty, error) {
+	err := s.service.RemoveGameRelation(ctx, uint(req.GetGameId()), uint(req.GetRelationId()))
+	if err != nil {
+		s.logger.WithError(err).Error("Error removing game relation")
+		return nil, toStatusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *GameServer) ListGameTranslations(ctx context.Context, req *pb.ListGameTranslationsRequest) (*pb.GameTranslationsResponse, error) {
+	translations, err := s.service.GetGameTranslations(ctx, uint(req.GetGameId()))
+	if err != nil {
+		s.logger.WithError(err).Error("Error retrieving game translations")
+		return nil, toStatusError(err)
+	}
+
+	return &pb.GameTranslationsResponse{Translations: toProtoTranslations(translations)}, nil
+}
+
+func (s *GameServer) SetGameTranslation(ctx context.Context, req *pb.SetGameTranslationRequest) (*pb.GameTranslation, error) {
+	translation := &models.GameTranslation{
+		GameID:      uint(req.GetGameId()),
+		Locale:      req.GetLocale(),
+		Title:       req.GetTitle(),
+		Description: req.GetDescription(),
+	}
+
+	err := s.service.SetGameTranslation(ctx, translation)
+	if err != nil {
+		s.logger.WithError(err).Error("Error saving game translation")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoTranslation(*translation), nil
+}
+
+func (s *GameServer) DeleteGameTranslation(ctx context.Context, req *pb.DeleteGameTranslationRequest) (*emptypb.Empty, error) {
+	err := s.service.DeleteGameTranslation(ctx, uint(req.GetGameId()), req.GetLocale())
+	if err != nil {
+		s.logger.WithError(err).Error("Error deleting game translation")
+		return nil, toStatusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *GameServer) ListGameMedia(ctx context.Context, req *pb.ListGameMediaRequest) (*pb.GameMediaResponse, error) {
+	media, err := s.service.GetGameMedia(ctx, uint(req.GetGameId()))
+	if err != nil {
+		s.logger.WithError(err).Error("Error retrieving game media")
+		return nil, toStatusError(err)
+	}
+
+	return &pb.GameMediaResponse{Media: toProtoMedia(media)}, nil
+}
+
+func (s *GameServer) AddGameMedia(ctx context.Context, req *pb.AddGameMediaRequest) (*pb.GameMedia, error) {
+	media := &models.GameMedia{
+		GameID:  uint(req.GetGameId()),
+		Type:    req.GetType(),
+		URL:     req.GetUrl(),
+		Caption: req.GetCaption(),
+		Width:   int(req.GetWidth()),
+		Height:  int(req.GetHeight()),
+		Primary: req.GetPrimary(),
+	}
+
+	err := s.service.AddGameMedia(ctx, media)
+	if err != nil {
+		s.logger.WithError(err).Error("Error adding game media")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoGameMedia(*media), nil
+}
+
+func (s *GameServer) ReorderGameMedia(ctx context.Context, req *pb.ReorderGameMediaRequest) (*pb.GameMediaResponse, error) {
+	media, err := s.service.ReorderGameMedia(ctx, uint(req.GetGameId()), uintsFromProto(req.GetMediaIds()))
+	if err != nil {
+		s.logger.WithError(err).Error("Error reordering game media")
+		return nil, toStatusError(err)
+	}
+
+	return &pb.GameMediaResponse{Media: toProtoMedia(media)}, nil
+}
+
+func (s *GameServer) RemoveGameMedia(ctx context.Context, req *pb.RemoveGameMediaRequest) (*emptypb.Empty, error) {
+	err := s.service.RemoveGameMedia(ctx, uint(req.GetGameId()), uint(req.GetMediaId()))
+	if err != nil {
+		s.logger.WithError(err).Error("Error removing game media")
+		return nil, toStatusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *GameServer) VoteGameTag(ctx context.Context, req *pb.VoteGameTagRequest) (*pb.GameTag, error) {
+	gameTag, err := s.service.VoteGameTag(ctx, uint(req.GetGameId()), req.GetName(), tagVoter(ctx, req.GetVoterId()))
+	if err != nil {
+		s.logger.WithError(err).Error("Error voting for game tag")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoGameTag(*gameTag), nil
+}
+
+func (s *GameServer) RemoveGameTagVote(ctx context.Context, req *pb.RemoveGameTagVoteRequest) (*emptypb.Empty, error) {
+	err := s.service.RemoveGameTagVote(ctx, uint(req.GetGameId()), uint(req.GetTagId()), tagVoter(ctx, req.GetVoterId()))
+	if err != nil {
+		s.logger.WithError(err).Error("Error removing game tag vote")
+		return nil, toStatusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// tagVoter identifie l'auteur d'un vote pour un tag : l'identifiant fourni
+// dans la requête, sinon l'adresse de l'appelant.
+func tagVoter(ctx context.Context, voterID string) string {
+	voterID = strings.TrimSpace(voterID)
+	if voterID != "" {
+		return "id:" + voterID
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	return "ip:" + host
+}
+
+func (s *GameServer) CreateGenre(ctx context.Context, req *pb.CreateGenreRequest) (*pb.Genre, error) {
+	genre := &models.Genre{Name: req.GetName()}
+
+	err := s.service.CreateGenre(ctx, genre)
+	if err != nil {
+		s.logger.WithError(err).Error("Error creating genre")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoGenre(*genre), nil
+}
+
+func (s *GameServer) GetAllGenres(ctx context.Context, _ *emptypb.Empty) (*pb.GenresResponse, error) {
+	genres, err := s.service.GetAllGenres(ctx)
+	if err != nil {
+		s.logger.WithError(err).Error("Error retrieving genres")
+		return nil, toStatusError(err)
+	}
+
+	return &pb.GenresResponse{Genres: toProtoGenres(genres)}, nil
+}
+
+func (s *GameServer) GetGenre(ctx context.Context, req *pb.GetGenreRequest) (*pb.Genre, error) {
+	genre, err := s.service.GetGenreByID(ctx, uint(req.GetId()))
+	if err != nil {
+		s.logger.WithError(err).Error("Error retrieving genre")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoGenre(*genre), nil
+}
+
+func (s *GameServer) UpdateGenre(ctx context.Context, req *pb.UpdateGenreRequest) (*pb.Genre, error) {
+	genre := &models.Genre{ID: uint(req.GetId()), Name: req.GetName()}
+
+	err := s.service.UpdateGenre(ctx, genre)
+	if err != nil {
+		s.logger.WithError(err).Error("Error updating genre")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoGenre(*genre), nil
+}
+
+func (s *GameServer) DeleteGenre(ctx context.Context, req *pb.DeleteGenreRequest) (*emptypb.Empty, error) {
+	err := s.service.DeleteGenre(ctx, uint(req.GetId()), req.GetCascade())
+	if err != nil {
+		s.logger.WithError(err).Error("Error deleting genre")
+		return nil, toStatusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *GameServer) MergeGenres(ctx context.Context, req *pb.MergeGenresRequest) (*pb.Genre, error) {
+	genre, err := s.service.MergeGenres(ctx, uint(req.GetSourceId()), uint(req.GetTargetId()))
+	if err != nil {
+		s.logger.WithError(err).Error("Error merging genres")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoGenre(*genre), nil
+}
+
+func (s *GameServer) CreatePlatform(ctx context.Context, req *pb.CreatePlatformRequest) (*pb.Platform, error) {
+	platform := &models.Platform{Name: req.GetName()}
+
+	err := s.service.CreatePlatform(ctx, platform)
+	if err != nil {
+		s.logger.WithError(err).Error("Error creating platform")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoPlatform(*platform), nil
+}
+
+func (s *GameServer) GetAllPlatforms(ctx context.Context, _ *emptypb.Empty) (*pb.PlatformsResponse, error) {
+	platforms, err := s.service.GetAllPlatforms(ctx)
+	if err != nil {
+		s.logger.WithError(err).Error("Error retrieving platforms")
+		return nil, toStatusError(err)
+	}
+
+	return &pb.PlatformsResponse{Platforms: toProtoPlatforms(platforms)}, nil
+}
+
+func (s *GameServer) GetPlatform(ctx context.Context, req *pb.GetPlatformRequest) (*pb.Platform, error) {
+	platform, err := s.service.GetPlatformByID(ctx, uint(req.GetId()))
+	if err != nil {
+		s.logger.WithError(err).Error("Error retrieving platform")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoPlatform(*platform), nil
+}
+
+func (s *GameServer) UpdatePlatform(ctx context.Context, req *pb.UpdatePlatformRequest) (*pb.Platform, error) {
+	platform := &models.Platform{ID: uint(req.GetId()), Name: req.GetName()}
+
+	err := s.service.UpdatePlatform(ctx, platform)
+	if err != nil {
+		s.logger.WithError(err).Error("Error updating platform")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoPlatform(*platform), nil
+}
+
+func (s *GameServer) DeletePlatform(ctx context.Context, req *pb.DeletePlatformRequest) (*emptypb.Empty, error) {
+	err := s.service.DeletePlatform(ctx, uint(req.GetId()), req.GetCascade())
+	if err != nil {
+		s.logger.WithError(err).Error("Error deleting platform")
+		return nil, toStatusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *GameServer) MergePlatforms(ctx context.Context, req *pb.MergePlatformsRequest) (*pb.Platform, error) {
+	platform, err := s.service.MergePlatforms(ctx, uint(req.GetSourceId()), uint(req.GetTargetId()))
+	if err != nil {
+		s.logger.WithError(err).Error("Error merging platforms")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoPlatform(*platform), nil
+}
+
+func (s *GameServer) CreateCompany(ctx context.Context, req *pb.CreateCompanyRequest) (*pb.Company, error) {
+	company := &models.Company{Name: req.GetName(), Website: req.GetWebsite()}
+
+	err := s.service.CreateCompany(ctx, company)
+	if err != nil {
+		s.logger.WithError(err).Error("Error creating company")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoCompany(*company), nil
+}
+
+func (s *GameServer) GetAllCompanies(ctx context.Context, _ *emptypb.Empty) (*pb.CompaniesResponse, error) {
+	companies, err := s.service.GetAllCompanies(ctx)
+	if err != nil {
+		s.logger.WithError(err).Error("Error retrieving companies")
+		return nil, toStatusError(err)
+	}
+
+	return &pb.CompaniesResponse{Companies: toProtoCompanies(companies)}, nil
+}
+
+func (s *GameServer) GetCompany(ctx context.Context, req *pb.GetCompanyRequest) (*pb.Company, error) {
+	company, err := s.service.GetCompanyByID(ctx, uint(req.GetId()))
+	if err != nil {
+		s.logger.WithError(err).Error("Error retrieving company")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoCompany(*company), nil
+}
+
+func (s *GameServer) UpdateCompany(ctx context.Context, req *pb.UpdateCompanyRequest) (*pb.Company, error) {
+	company := &models.Company{ID: uint(req.GetId()), Name: req.GetName(), Website: req.GetWebsite()}
+
+	err := s.service.UpdateCompany(ctx, company)
+	if err != nil {
+		s.logger.WithError(err).Error("Error updating company")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoCompany(*company), nil
+}
+
+func (s *GameServer) DeleteCompany(ctx context.Context, req *pb.DeleteCompanyRequest) (*emptypb.Empty, error) {
+	err := s.service.DeleteCompany(ctx, uint(req.GetId()), req.GetCascade())
+	if err != nil {
+		s.logger.WithError(err).Error("Error deleting company")
+		return nil, toStatusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *GameServer) CreateFranchise(ctx context.Context, req *pb.CreateFranchiseRequest) (*pb.Franchise, error) {
+	franchise := &models.Franchise{Name: req.GetName(), Description: req.GetDescription()}
+
+	err := s.service.CreateFranchise(ctx, franchise)
+	if err != nil {
+		s.logger.WithError(err).Error("Error creating franchise")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoFranchise(*franchise), nil
+}
+
+func (s *GameServer) GetAllFranchises(ctx context.Context, _ *emptypb.Empty) (*pb.FranchisesResponse, error) {
+	franchises, err := s.service.GetAllFranchises(ctx)
+	if err != nil {
+		s.logger.WithError(err).Error("Error retrieving franchises")
+		return nil, toStatusError(err)
+	}
+
+	return &pb.FranchisesResponse{Franchises: toProtoFranchises(franchises)}, nil
+}
+
+func (s *GameServer) GetFranchise(ctx context.Context, req *pb.GetFranchiseRequest) (*pb.Franchise, error) {
+	franchise, err := s.service.GetFranchiseByID(ctx, uint(req.GetId()))
+	if err != nil {
+		s.logger.WithError(err).Error("Error retrieving franchise")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoFranchise(*franchise), nil
+}
+
+func (s *GameServer) UpdateFranchise(ctx context.Context, req *pb.UpdateFranchiseRequest) (*pb.Franchise, error) {
+	franchise := &models.Franchise{ID: uint(req.GetId()), Name: req.GetName(), Description: req.GetDescription()}
+
+	err := s.service.UpdateFranchise(ctx, franchise)
+	if err != nil {
+		s.logger.WithError(err).Error("Error updating franchise")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoFranchise(*franchise), nil
+}
+
+func (s *GameServer) DeleteFranchise(ctx context.Context, req *pb.DeleteFranchiseRequest) (*emptypb.Empty, error) {
+	err := s.service.DeleteFranchise(ctx, uint(req.GetId()))
+	if err != nil {
+		s.logger.WithError(err).Error("Error deleting franchise")
+		return nil, toStatusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *GameServer) GetTags(ctx context.Context, req *pb.GetTagsRequest) (*pb.TagsResponse, error) {
+	tags, err := s.service.GetTags(ctx, req.GetStatus())
+	if err != nil {
+		s.logger.WithError(err).Error("Error retrieving tags")
+		return nil, toStatusError(err)
+	}
+
+	return &pb.TagsResponse{Tags: toProtoTags(tags)}, nil
+}
+
+func (s *GameServer) SuggestTag(ctx context.Context, req *pb.SuggestTagRequest) (*pb.Tag, error) {
+	tag, _, err := s.service.SuggestTag(ctx, req.GetName())
+	if err != nil {
+		s.logger.WithError(err).Error("Error suggesting tag")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoTag(*tag), nil
+}
+
+func (s *GameServer) ApproveTag(ctx context.Context, req *pb.ModerateTagRequest) (*pb.Tag, error) {
+	tag, err := s.service.ApproveTag(ctx, uint(req.GetId()))
+	if err != nil {
+		s.logger.WithError(err).Error("Error approving tag")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoTag(*tag), nil
+}
+
+func (s *GameServer) RejectTag(ctx context.Context, req *pb.ModerateTagRequest) (*pb.Tag, error) {
+	tag, err := s.service.RejectTag(ctx, uint(req.GetId()))
+	if err != nil {
+		s.logger.WithError(err).Error("Error rejecting tag")
+		return nil, toStatusError(err)
+	}
+
+	return toProtoTag(*tag), nil
+}
@@ -0,0 +1,164 @@
+package grpc
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	pb "github.com/NNNACHID/api-game-catalog-cl/api/proto/catalog"
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+	"github.com/NNNACHID/api-game-catalog-cl/internal/service"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// stubService ne surcharge que les méthodes utilisées par les tests ; les
+// autres paniquent via l'interface nil embarquée.
+type stubService struct {
+	service.GameService
+	games  map[uint]*models.Game
+	filter *models.GameFilter
+}
+
+func (s *stubService) GetGameByID(ctx context.Context, id uint) (*models.Game, error) {
+	game, ok := s.games[id]
+	if !ok {
+		return nil, apperrors.NotFound("game not found")
+	}
+	return game, nil
+}
+
+func (s *stubService) CreateGame(ctx context.Context, game *models.Game) error {
+	if game.Title == "" {
+		return apperrors.InvalidFields("Validation failed", apperrors.FieldError{
+			Field:   "title",
+			Message: "le titre du jeu est obligatoire",
+		})
+	}
+	game.ID = 42
+	return nil
+}
+
+func (s *stubService) ListGames(ctx context.Context, filter *models.GameFilter) (*models.GameResponse, error) {
+	s.filter = filter
+	games := make([]models.Game, 0, len(s.games))
+	for _, game := range s.games {
+		games = append(games, *game)
+	}
+	return &models.GameResponse{Games: games, TotalCount: int64(len(games)), Page: 1, PageSize: 10, TotalPages: 1}, nil
+}
+
+func (s *stubService) LocalizeGames(ctx context.Context, games []models.Game, languages []string) error {
+	return nil
+}
+
+func newTestClient(t *testing.T, svc service.GameService) pb.CatalogServiceClient {
+	t.Helper()
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	NewGameServer(svc, logger).RegisterServer(server)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return pb.NewCatalogServiceClient(conn)
+}
+
+func TestGameServerSmoke(t *testing.T) {
+	svc := &stubService{games: map[uint]*models.Game{
+		7: {
+			ID:            7,
+			Title:         "Hollow Knight",
+			Slug:          "hollow-knight",
+			Developer:     "Team Cherry",
+			AverageRating: 4.7,
+			Price:         decimal.RequireFromString("14.99"),
+			Currency:      "EUR",
+		},
+	}}
+	client := newTestClient(t, svc)
+	ctx := context.Background()
+
+	t.Run("GetGame", func(t *testing.T) {
+		game, err := client.GetGame(ctx, &pb.GetGameRequest{Id: 7})
+
+		require.NoError(t, err)
+		assert.Equal(t, uint32(7), game.GetId())
+		assert.Equal(t, "Hollow Knight", game.GetTitle())
+		assert.Equal(t, "hollow-knight", game.GetSlug())
+		assert.Equal(t, "Team Cherry", game.GetDeveloper())
+		assert.Equal(t, 4.7, game.GetAverageRating())
+		assert.Equal(t, 14.99, game.GetPrice())
+		assert.Equal(t, "EUR", game.GetCurrency())
+	})
+
+	t.Run("GetGame introuvable", func(t *testing.T) {
+		_, err := client.GetGame(ctx, &pb.GetGameRequest{Id: 404})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("GetGame include inconnu", func(t *testing.T) {
+		_, err := client.GetGame(ctx, &pb.GetGameRequest{Id: 7, Include: []string{"reviews"}})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("CreateGame", func(t *testing.T) {
+		game, err := client.CreateGame(ctx, &pb.CreateGameRequest{Title: "Celeste", Price: 19.99})
+
+		require.NoError(t, err)
+		assert.Equal(t, uint32(42), game.GetId())
+		assert.Equal(t, "Celeste", game.GetTitle())
+	})
+
+	t.Run("CreateGame invalide", func(t *testing.T) {
+		_, err := client.CreateGame(ctx, &pb.CreateGameRequest{})
+
+		st := status.Convert(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		require.Len(t, st.Details(), 1)
+		badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+		require.True(t, ok)
+		require.Len(t, badRequest.GetFieldViolations(), 1)
+		assert.Equal(t, "title", badRequest.GetFieldViolations()[0].GetField())
+	})
+
+	t.Run("ListGames", func(t *testing.T) {
+		minRating := 4.0
+		response, err := client.ListGames(ctx, &pb.ListGamesRequest{
+			MinRating: &minRating,
+			GenreIds:  []uint32{3},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, response.GetGames(), 1)
+		assert.Equal(t, "Hollow Knight", response.GetGames()[0].GetTitle())
+		assert.Equal(t, int64(1), response.GetTotalCount())
+		require.NotNil(t, svc.filter.MinRating)
+		assert.Equal(t, 4.0, *svc.filter.MinRating)
+		assert.Equal(t, []uint{3}, svc.filter.GenreIDs)
+	})
+}
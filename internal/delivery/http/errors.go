@@ -0,0 +1,138 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/sirupsen/logrus"
+)
+
+const problemContentType = "application/problem+json"
+
+// Problem est l'enveloppe d'erreur RFC 7807 renvoyée par toute l'API.
+type Problem struct {
+	Type     string                 `json:"type"`
+	Title    string                 `json:"title"`
+	Status   int                    `json:"status"`
+	Detail   string                 `json:"detail,omitempty"`
+	Instance string                 `json:"instance,omitempty"`
+	Errors   []apperrors.FieldError `json:"errors,omitempty"`
+}
+
+var statusByCode = map[apperrors.Code]int{
+	apperrors.CodeNotFound:   http.StatusNotFound,
+	apperrors.CodeConflict:   http.StatusConflict,
+	apperrors.CodeValidation: http.StatusBadRequest,
+	apperrors.CodeInternal:   http.StatusInternalServerError,
+}
+
+var titleByCode = map[apperrors.Code]string{
+	apperrors.CodeNotFound:   "Resource not found",
+	apperrors.CodeConflict:   "Resource conflict",
+	apperrors.CodeValidation: "Validation failed",
+	apperrors.CodeInternal:   "Internal server error",
+}
+
+func problemType(code apperrors.Code) string {
+	return "/problems/" + strings.ReplaceAll(string(code), "_", "-")
+}
+
+// ErrorHandler traduit la dernière erreur attachée au contexte Gin en réponse
+// application/problem+json.
+func ErrorHandler(logger *logrus.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+
+		err := c.Errors.Last().Err
+		code := apperrors.CodeOf(err)
+		status, ok := statusByCode[code]
+		if !ok {
+			code = apperrors.CodeInternal
+			status = http.StatusInternalServerError
+		}
+
+		entry := logger.WithError(err).WithFields(logrus.Fields{
+			"path":   c.Request.URL.Path,
+			"method": c.Request.Method,
+			"code":   code,
+		})
+		if status >= http.StatusInternalServerError {
+			entry.Error("Erreur lors du traitement de la requête")
+		} else {
+			entry.Warn("Requête rejetée")
+		}
+
+		writeProblem(c, Problem{
+			Type:     problemType(code),
+			Title:    titleByCode[code],
+			Status:   status,
+			Detail:   apperrors.MessageOf(err),
+			Instance: c.Request.URL.RequestURI(),
+			Errors:   apperrors.FieldsOf(err),
+		})
+	}
+}
+
+// NoRouteHandler répond aux URL inconnues par un document problem+json.
+func NoRouteHandler(c *gin.Context) {
+	writeProblem(c, Problem{
+		Type:     problemType(apperrors.CodeNotFound),
+		Title:    titleByCode[apperrors.CodeNotFound],
+		Status:   http.StatusNotFound,
+		Detail:   "no route matches " + c.Request.Method + " " + c.Request.URL.Path,
+		Instance: c.Request.URL.RequestURI(),
+	})
+}
+
+// NoMethodHandler répond aux méthodes non prises en charge par une route
+// existante par un document problem+json.
+func NoMethodHandler(c *gin.Context) {
+	writeProblem(c, Problem{
+		Type:     "/problems/method-not-allowed",
+		Title:    "Method not allowed",
+		Status:   http.StatusMethodNotAllowed,
+		Detail:   "method " + c.Request.Method + " is not allowed on " + c.Request.URL.Path,
+		Instance: c.Request.URL.RequestURI(),
+	})
+}
+
+func writeProblem(c *gin.Context, problem Problem) {
+	c.Header("Content-Type", problemContentType)
+	c.JSON(problem.Status, problem)
+}
+
+// bindingError convertit une erreur de désérialisation ou de binding Gin en
+// erreur de validation, avec le détail par champ quand il est disponible.
+func bindingError(err error, message string) error {
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		fields := make([]apperrors.FieldError, 0, len(validationErrors))
+		for _, fieldErr := range validationErrors {
+			fields = append(fields, apperrors.FieldError{
+				Field:   fieldErr.Field(),
+				Message: "failed on the '" + fieldErr.Tag() + "' rule",
+			})
+		}
+
+		return apperrors.InvalidFields(message, fields...)
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return apperrors.InvalidFields(message, apperrors.FieldError{
+			Field:   typeErr.Field,
+			Message: "expected a value of type " + typeErr.Type.String(),
+		})
+	}
+
+	return apperrors.Wrap(apperrors.CodeValidation, err, "%s", message)
+}
@@ -0,0 +1,96 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorHandler(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		typ    string
+		detail string
+		fields []apperrors.FieldError
+	}{
+		{
+			"introuvable", apperrors.NotFound("game not found"),
+			http.StatusNotFound, "/problems/not-found", "game not found", nil,
+		},
+		{
+			"conflit", apperrors.Conflict("genre already exists"),
+			http.StatusConflict, "/problems/conflict", "genre already exists", nil,
+		},
+		{
+			"validation avec champs",
+			apperrors.InvalidFields("Validation failed",
+				apperrors.FieldError{Field: "title", Message: "le titre du jeu est obligatoire"},
+				apperrors.FieldError{Field: "prices[0].amount", Message: "le montant doit être positif"},
+			),
+			http.StatusBadRequest, "/problems/validation", "Validation failed",
+			[]apperrors.FieldError{
+				{Field: "title", Message: "le titre du jeu est obligatoire"},
+				{Field: "prices[0].amount", Message: "le montant doit être positif"},
+			},
+		},
+		{
+			"erreur interne masquée", apperrors.Internal(errors.New("pq: password authentication failed")),
+			http.StatusInternalServerError, "/problems/internal", apperrors.ErrInternal.Message, nil,
+		},
+		{
+			"erreur non typée masquée", errors.New("dial tcp 10.0.0.3:5432: connection refused"),
+			http.StatusInternalServerError, "/problems/internal", apperrors.ErrInternal.Message, nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.Use(ErrorHandler(quietLogger()))
+			router.GET("/games/:ref", func(c *gin.Context) { _ = c.Error(tt.err) })
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/games/7?include=media", nil))
+
+			assert.Equal(t, tt.status, recorder.Code)
+			problem := decodeProblem(t, recorder)
+			assert.Equal(t, tt.status, problem.Status)
+			assert.Equal(t, tt.typ, problem.Type)
+			assert.Equal(t, tt.detail, problem.Detail)
+			assert.Equal(t, "/games/7?include=media", problem.Instance)
+			assert.Equal(t, tt.fields, problem.Errors)
+			assert.NotContains(t, recorder.Body.String(), "10.0.0.3")
+			assert.NotContains(t, recorder.Body.String(), "password")
+		})
+	}
+
+	t.Run("réponse déjà écrite conservée", func(t *testing.T) {
+		gin.SetMode(gin.TestMode)
+		router := gin.New()
+		router.Use(ErrorHandler(quietLogger()))
+		router.GET("/games", func(c *gin.Context) {
+			c.JSON(http.StatusOK, gin.H{"games": []string{}})
+			_ = c.Error(errors.New("late error"))
+		})
+
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/games", nil))
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.JSONEq(t, `{"games": []}`, recorder.Body.String())
+	})
+}
+
+func TestBindingError(t *testing.T) {
+	err := bindingError(errors.New("unexpected EOF"), "Invalid request body")
+
+	assert.ErrorIs(t, err, apperrors.ErrValidation)
+	assert.Equal(t, "Invalid request body", apperrors.MessageOf(err))
+}
@@ -1,14 +1,18 @@
 package http
 
 import (
+	"io"
 	"net/http"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
 	"github.com/NNNACHID/api-game-catalog-cl/internal/service"
 	"github.com/gin-gonic/gin"
-	//"github.com/golangci/golangci-lint/pkg/golinters/iface"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/text/language"
 )
 
 type GameHandler struct {
@@ -23,184 +27,1178 @@ func NewGameHandler(service service.GameService, logger *logrus.Logger) *GameHan
 	}
 }
 
+func parseID(c *gin.Context, param string) (uint, error) {
+	id, err := strconv.ParseUint(c.Param(param), 10, 32)
+	if err != nil {
+		return 0, apperrors.InvalidFields("Invalid "+param, apperrors.FieldError{
+			Field:   param,
+			Message: "must be a positive integer",
+		})
+	}
+
+	return uint(id), nil
+}
+
+// gameID résout le paramètre :ref des routes /games/:ref (identifiant
+// numérique, UUID ou slug, courant ou ancien) en identifiant de jeu.
+func (h *GameHandler) gameID(c *gin.Context) (uint, error) {
+	return h.service.ResolveGameRef(c.Request.Context(), c.Param("ref"))
+}
+
+// parseBoolQuery lit un paramètre de requête booléen optionnel (faux par défaut).
+func parseBoolQuery(c *gin.Context, param string) (bool, error) {
+	raw := c.Query(param)
+	if raw == "" {
+		return false, nil
+	}
+
+	value, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, apperrors.InvalidFields("Invalid "+param, apperrors.FieldError{
+			Field:   param,
+			Message: "must be a boolean",
+		})
+	}
+
+	return value, nil
+}
+
+// parseIncludes lit le paramètre include (valeurs séparées par des virgules ou
+// répétées) et rejette les valeurs inconnues.
+func parseIncludes(c *gin.Context, allowed ...string) (map[string]bool, error) {
+	includes := make(map[string]bool)
+	for _, raw := range c.QueryArray("include") {
+		for _, include := range strings.Split(raw, ",") {
+			include = strings.TrimSpace(include)
+			if include == "" {
+				continue
+			}
+			if !slices.Contains(allowed, include) {
+				return nil, apperrors.InvalidFields("Invalid include", apperrors.FieldError{
+					Field:   "include",
+					Message: "must be one of: " + strings.Join(allowed, ", "),
+				})
+			}
+			includes[include] = true
+		}
+	}
+
+	return includes, nil
+}
+
+// parseLanguages détermine les langues souhaitées par ordre de préférence : le
+// paramètre lang (valeurs séparées par des virgules ou répétées) s'il est
+// présent, sinon l'en-tête Accept-Language, ignoré s'il est malformé.
+func parseLanguages(c *gin.Context) ([]string, error) {
+	var languages []string
+	for _, raw := range c.QueryArray("lang") {
+		for _, lang := range strings.Split(raw, ",") {
+			lang = strings.TrimSpace(lang)
+			if lang == "" {
+				continue
+			}
+			_, err := language.Parse(lang)
+			if err != nil {
+				return nil, apperrors.InvalidFields("Invalid lang", apperrors.FieldError{
+					Field:   "lang",
+					Message: "must be a BCP 47 language tag, e.g. fr or en-US",
+				})
+			}
+			languages = append(languages, lang)
+		}
+	}
+	if len(languages) > 0 {
+		return languages, nil
+	}
+
+	c.Header("Vary", "Accept-Language")
+	tags, weights, err := language.ParseAcceptLanguage(c.GetHeader("Accept-Language"))
+	if err != nil {
+		return nil, nil
+	}
+	for i, tag := range tags {
+		if weights[i] > 0 && tag != language.Und {
+			languages = append(languages, tag.String())
+		}
+	}
+	return languages, nil
+}
+
+// mediaOrderRequest est le corps d'une réorganisation de galerie.
+type mediaOrderRequest struct {
+	MediaIDs []uint `json:"media_ids" binding:"required"`
+}
+
+// tagRequest est le corps d'une proposition de tag ou d'un vote pour un tag.
+type tagRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+// mergeRequest est le corps des requêtes de fusion de genres ou de plateformes.
+type mergeRequest struct {
+	TargetID uint `json:"target_id" binding:"required"`
+}
+
+// tagVoter identifie l'auteur d'un vote pour un tag : l'en-tête X-Voter-ID
+// s'il est fourni, sinon l'adresse IP du client.
+func tagVoter(c *gin.Context) string {
+	voterID := strings.TrimSpace(c.GetHeader("X-Voter-ID"))
+	if voterID != "" {
+		return "id:" + voterID
+	}
+	return "ip:" + c.ClientIP()
+}
+
+func invalidRequest(err error) error {
+	return bindingError(err, "Invalid request format")
+}
+
 func (h *GameHandler) CreateGame(c *gin.Context) {
 	var game models.Game
-	
+
 	err := c.ShouldBindJSON(&game)
 	if err != nil {
-		h.logger.WithError(err).Error("Error deserializing request")
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
-
+		_ = c.Error(invalidRequest(err))
 		return
 	}
-	
+
 	err = h.service.CreateGame(c.Request.Context(), &game)
 	if err != nil {
-		h.logger.WithError(err).Error("Error creating game")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-
+		_ = c.Error(err)
 		return
 	}
-	
+
 	c.JSON(http.StatusCreated, game)
 }
 
 func (h *GameHandler) GetGame(c *gin.Context) {
-	idStr := c.Param("id")
-	id, err := strconv.ParseUint(idStr, 10, 32)
+	ref := c.Param("ref")
+	includes, err := parseIncludes(c, "related", "translations", "media")
 	if err != nil {
-		h.logger.WithError(err).Error("Invalid game ID")
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid game ID"})
+		_ = c.Error(err)
+		return
+	}
 
+	languages, err := parseLanguages(c)
+	if err != nil {
+		_ = c.Error(err)
 		return
 	}
-	
-	game, err := h.service.GetGameByID(c.Request.Context(), uint(id))
+
+	game, moved, err := h.service.GetGameByRef(c.Request.Context(), ref)
 	if err != nil {
-		h.logger.WithError(err).Error("Error retrieving game")
-		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		_ = c.Error(err)
+		return
+	}
 
+	if moved {
+		location := strings.TrimSuffix(c.Request.URL.Path, ref) + game.Slug
+		if c.Request.URL.RawQuery != "" {
+			location += "?" + c.Request.URL.RawQuery
+		}
+		c.Redirect(http.StatusMovedPermanently, location)
 		return
 	}
-	
-	c.JSON(http.StatusOK, game)
+
+	if includes["related"] {
+		game.Related, err = h.service.GetRelatedGames(c.Request.Context(), game)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		err = h.service.LocalizeRelatedGames(c.Request.Context(), game.Related, languages)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+	}
+
+	if includes["translations"] {
+		game.Translations, err = h.service.GetGameTranslations(c.Request.Context(), game.ID)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+	}
+
+	games := []models.Game{*game}
+	if includes["media"] {
+		err = h.service.EmbedMedia(c.Request.Context(), games)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+	}
+
+	err = h.service.LocalizeGames(c.Request.Context(), games, languages)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, games[0])
 }
 
-func (h *GameHandler) UpdateGame(c *gin.Context) {
-	idStr := c.Param("id")
-	id, err := strconv.ParseUint(idStr, 10, 32)
+func (h *GameHandler) GetGameByExternalID(c *gin.Context) {
+	languages, err := parseLanguages(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	game, err := h.service.GetGameByExternalID(c.Request.Context(), c.Param("provider"), c.Param("external_id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	games := []models.Game{*game}
+	err = h.service.LocalizeGames(c.Request.Context(), games, languages)
 	if err != nil {
-		h.logger.WithError(err).Error("Invalid game ID")
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid game ID"})
+		_ = c.Error(err)
+		return
+	}
 
+	c.JSON(http.StatusOK, games[0])
+}
+
+func (h *GameHandler) UpdateGame(c *gin.Context) {
+	id, err := h.gameID(c)
+	if err != nil {
+		_ = c.Error(err)
 		return
 	}
-	
+
 	var game models.Game
-	if err := c.ShouldBindJSON(&game); err != nil {
-		h.logger.WithError(err).Error("Error deserializing request")
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+	err = c.ShouldBindJSON(&game)
+	if err != nil {
+		_ = c.Error(invalidRequest(err))
+		return
+	}
 
+	game.ID = id
+
+	err = h.service.UpdateGame(c.Request.Context(), &game)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, game)
+}
+
+func (h *GameHandler) PatchGame(c *gin.Context) {
+	id, err := h.gameID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	patch, err := io.ReadAll(c.Request.Body)
+	if err != nil || len(patch) == 0 {
+		_ = c.Error(apperrors.Wrap(apperrors.CodeValidation, err, "Invalid request format"))
 		return
 	}
-	
-	game.ID = uint(id)
-	
-	if err := h.service.UpdateGame(c.Request.Context(), &game); err != nil {
-		h.logger.WithError(err).Error("Error updating the game")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 
+	game, err := h.service.PatchGame(c.Request.Context(), id, patch)
+	if err != nil {
+		_ = c.Error(err)
 		return
 	}
-	
+
 	c.JSON(http.StatusOK, game)
 }
 
 func (h *GameHandler) DeleteGame(c *gin.Context) {
-	idStr := c.Param("id")
-	id, err := strconv.ParseUint(idStr, 10, 32)
+	id, err := h.gameID(c)
 	if err != nil {
-		h.logger.WithError(err).Error("Invalid game ID")
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid game ID"})
+		_ = c.Error(err)
+		return
+	}
 
+	err = h.service.DeleteGame(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
 		return
 	}
-	
-	err = h.service.DeleteGame(c.Request.Context(), uint(id)) 
+
+	c.JSON(http.StatusOK, gin.H{"message": "Game deleted successfully"})
+}
+
+func (h *GameHandler) RestoreGame(c *gin.Context) {
+	id, err := h.gameID(c)
 	if err != nil {
-		h.logger.WithError(err).Error("Error deleting game")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = c.Error(err)
+		return
+	}
 
+	game, err := h.service.RestoreGame(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
 		return
 	}
-	
-	c.JSON(http.StatusOK, gin.H{"message": "Game deleted successfully"})
+
+	c.JSON(http.StatusOK, game)
 }
 
-func (h *GameHandler) ListGames(c *gin.Context) {
-	var filter models.GameFilter
-	
-	err := c.ShouldBindQuery(&filter)
+func (h *GameHandler) AddGameGenre(c *gin.Context) {
+	id, err := h.gameID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	genreID, err := parseID(c, "genre_id")
 	if err != nil {
-		h.logger.WithError(err).Error("Error deserializing request params")
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query params"})
+		_ = c.Error(err)
+		return
+	}
 
+	game, err := h.service.AddGenreToGame(c.Request.Context(), id, genreID)
+	if err != nil {
+		_ = c.Error(err)
 		return
 	}
-	
-	games, err := h.service.ListGames(c.Request.Context(), &filter)
+
+	c.JSON(http.StatusOK, game)
+}
+
+func (h *GameHandler) RemoveGameGenre(c *gin.Context) {
+	id, err := h.gameID(c)
 	if err != nil {
-		h.logger.WithError(err).Error("Error retrieving games")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = c.Error(err)
+		return
+	}
 
+	genreID, err := parseID(c, "genre_id")
+	if err != nil {
+		_ = c.Error(err)
 		return
 	}
-	
-	c.JSON(http.StatusOK, games)
+
+	game, err := h.service.RemoveGenreFromGame(c.Request.Context(), id, genreID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, game)
 }
 
-func (h *GameHandler) CreateGenre(c *gin.Context) {
-	var genre models.Genre
-	
-	if err := c.ShouldBindJSON(&genre); err != nil {
-		h.logger.WithError(err).Error("Error deserializing request")
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query format"})
+func (h *GameHandler) AddGamePlatform(c *gin.Context) {
+	id, err := h.gameID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
 
+	platformID, err := parseID(c, "platform_id")
+	if err != nil {
+		_ = c.Error(err)
 		return
 	}
-	
-	if err := h.service.CreateGenre(c.Request.Context(), &genre); err != nil {
-		h.logger.WithError(err).Error("Error creating platform")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 
+	game, err := h.service.AddPlatformToGame(c.Request.Context(), id, platformID)
+	if err != nil {
+		_ = c.Error(err)
 		return
 	}
-	
-	c.JSON(http.StatusCreated, genre)
+
+	c.JSON(http.StatusOK, game)
 }
 
-func (h *GameHandler) GetAllGenres(c *gin.Context) {
-	genres, err := h.service.GetAllGenres(c.Request.Context())
+func (h *GameHandler) RemoveGamePlatform(c *gin.Context) {
+	id, err := h.gameID(c)
 	if err != nil {
-		h.logger.WithError(err).Error("Error retrieving genres")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = c.Error(err)
+		return
+	}
 
+	platformID, err := parseID(c, "platform_id")
+	if err != nil {
+		_ = c.Error(err)
 		return
 	}
-	
-	c.JSON(http.StatusOK, genres)
+
+	game, err := h.service.RemovePlatformFromGame(c.Request.Context(), id, platformID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, game)
 }
 
-func (h *GameHandler) CreatePlatform(c *gin.Context) {
-	platform := models.Platform{}
-	
-	err := c.ShouldBindJSON(&platform)
+func (h *GameHandler) AddGameRelation(c *gin.Context) {
+	id, err := h.gameID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var relation models.GameRelation
+	err = c.ShouldBindJSON(&relation)
 	if err != nil {
-		h.logger.WithError(err).Error("Error deserializing request")
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query format"})
+		_ = c.Error(invalidRequest(err))
+		return
+	}
+
+	relation.ID = 0
+	relation.GameID = id
 
+	err = h.service.AddGameRelation(c.Request.Context(), &relation)
+	if err != nil {
+		_ = c.Error(err)
 		return
 	}
-	
-	err = h.service.CreatePlatform(c.Request.Context(), &platform)
+
+	c.JSON(http.StatusCreated, relation)
+}
+
+func (h *GameHandler) RemoveGameRelation(c *gin.Context) {
+	id, err := h.gameID(c)
 	if err != nil {
-		h.logger.WithError(err).Error("Error creating platform")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = c.Error(err)
+		return
+	}
 
+	relationID, err := parseID(c, "relation_id")
+	if err != nil {
+		_ = c.Error(err)
 		return
 	}
-	
-	c.JSON(http.StatusCreated, platform)
+
+	err = h.service.RemoveGameRelation(c.Request.Context(), id, relationID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Relation deleted successfully"})
 }
 
-func (h *GameHandler) GetAllPlatforms(c *gin.Context) {
-	platforms, err := h.service.GetAllPlatforms(c.Request.Context())
+func (h *GameHandler) GetGameTranslations(c *gin.Context) {
+	id, err := h.gameID(c)
 	if err != nil {
-		h.logger.WithError(err).Error("Error retrieving platforms")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = c.Error(err)
+		return
+	}
 
+	translations, err := h.service.GetGameTranslations(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
 		return
 	}
-	
-	c.JSON(http.StatusOK, platforms)
+
+	c.JSON(http.StatusOK, translations)
+}
+
+func (h *GameHandler) SetGameTranslation(c *gin.Context) {
+	id, err := h.gameID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var translation models.GameTranslation
+	err = c.ShouldBindJSON(&translation)
+	if err != nil {
+		_ = c.Error(invalidRequest(err))
+		return
+	}
+
+	translation.ID = 0
+	translation.GameID = id
+	translation.Locale = c.Param("locale")
+
+	err = h.service.SetGameTranslation(c.Request.Context(), &translation)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, translation)
+}
+
+func (h *GameHandler) DeleteGameTranslation(c *gin.Context) {
+	id, err := h.gameID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	err = h.service.DeleteGameTranslation(c.Request.Context(), id, c.Param("locale"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Translation deleted successfully"})
+}
+
+func (h *GameHandler) GetGameMedia(c *gin.Context) {
+	id, err := h.gameID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	media, err := h.service.GetGameMedia(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, media)
+}
+
+func (h *GameHandler) AddGameMedia(c *gin.Context) {
+	id, err := h.gameID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var media models.GameMedia
+	err = c.ShouldBindJSON(&media)
+	if err != nil {
+		_ = c.Error(invalidRequest(err))
+		return
+	}
+
+	media.ID = 0
+	media.GameID = id
+
+	err = h.service.AddGameMedia(c.Request.Context(), &media)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, media)
+}
+
+func (h *GameHandler) ReorderGameMedia(c *gin.Context) {
+	id, err := h.gameID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var req mediaOrderRequest
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		_ = c.Error(invalidRequest(err))
+		return
+	}
+
+	media, err := h.service.ReorderGameMedia(c.Request.Context(), id, req.MediaIDs)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, media)
+}
+
+func (h *GameHandler) RemoveGameMedia(c *gin.Context) {
+	id, err := h.gameID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	mediaID, err := parseID(c, "media_id")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	err = h.service.RemoveGameMedia(c.Request.Context(), id, mediaID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Media deleted successfully"})
+}
+
+func (h *GameHandler) VoteGameTag(c *gin.Context) {
+	id, err := h.gameID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var req tagRequest
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		_ = c.Error(invalidRequest(err))
+		return
+	}
+
+	gameTag, err := h.service.VoteGameTag(c.Request.Context(), id, req.Name, tagVoter(c))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gameTag)
+}
+
+func (h *GameHandler) RemoveGameTagVote(c *gin.Context) {
+	id, err := h.gameID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	tagID, err := parseID(c, "tag_id")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	err = h.service.RemoveGameTagVote(c.Request.Context(), id, tagID, tagVoter(c))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Tag vote removed successfully"})
+}
+
+func (h *GameHandler) ListGames(c *gin.Context) {
+	var filter models.GameFilter
+
+	err := c.ShouldBindQuery(&filter)
+	if err != nil {
+		_ = c.Error(bindingError(err, "Invalid query params"))
+		return
+	}
+
+	includes, err := parseIncludes(c, "media")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	languages, err := parseLanguages(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	games, err := h.service.ListGames(c.Request.Context(), &filter)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	if includes["media"] {
+		err = h.service.EmbedMedia(c.Request.Context(), games.Games)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+	}
+
+	err = h.service.LocalizeGames(c.Request.Context(), games.Games, languages)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, games)
+}
+
+func (h *GameHandler) AutocompleteGames(c *gin.Context) {
+	limit := 0
+	if limitStr := c.Query("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil {
+			_ = c.Error(apperrors.InvalidFields("Invalid limit", apperrors.FieldError{
+				Field:   "limit",
+				Message: "must be an integer",
+			}))
+			return
+		}
+		limit = parsed
+	}
+
+	suggestions, err := h.service.AutocompleteTitles(c.Request.Context(), c.Query("prefix"), limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, suggestions)
+}
+
+func (h *GameHandler) CreateGenre(c *gin.Context) {
+	var genre models.Genre
+
+	err := c.ShouldBindJSON(&genre)
+	if err != nil {
+		_ = c.Error(invalidRequest(err))
+		return
+	}
+
+	err = h.service.CreateGenre(c.Request.Context(), &genre)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, genre)
+}
+
+func (h *GameHandler) GetAllGenres(c *gin.Context) {
+	genres, err := h.service.GetAllGenres(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, genres)
+}
+
+func (h *GameHandler) GetGenre(c *gin.Context) {
+	id, err := parseID(c, "id")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	genre, err := h.service.GetGenreByID(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, genre)
+}
+
+func (h *GameHandler) UpdateGenre(c *gin.Context) {
+	id, err := parseID(c, "id")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var genre models.Genre
+	err = c.ShouldBindJSON(&genre)
+	if err != nil {
+		_ = c.Error(invalidRequest(err))
+		return
+	}
+
+	genre.ID = id
+
+	err = h.service.UpdateGenre(c.Request.Context(), &genre)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, genre)
+}
+
+func (h *GameHandler) DeleteGenre(c *gin.Context) {
+	id, err := parseID(c, "id")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	cascade, err := parseBoolQuery(c, "cascade")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	err = h.service.DeleteGenre(c.Request.Context(), id, cascade)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Genre deleted successfully"})
+}
+
+func (h *GameHandler) MergeGenre(c *gin.Context) {
+	id, err := parseID(c, "id")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var req mergeRequest
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		_ = c.Error(invalidRequest(err))
+		return
+	}
+
+	genre, err := h.service.MergeGenres(c.Request.Context(), id, req.TargetID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, genre)
+}
+
+func (h *GameHandler) CreatePlatform(c *gin.Context) {
+	platform := models.Platform{}
+
+	err := c.ShouldBindJSON(&platform)
+	if err != nil {
+		_ = c.Error(invalidRequest(err))
+		return
+	}
+
+	err = h.service.CreatePlatform(c.Request.Context(), &platform)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, platform)
+}
+
+func (h *GameHandler) GetAllPlatforms(c *gin.Context) {
+	platforms, err := h.service.GetAllPlatforms(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, platforms)
+}
+
+func (h *GameHandler) GetPlatform(c *gin.Context) {
+	id, err := parseID(c, "id")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	platform, err := h.service.GetPlatformByID(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, platform)
+}
+
+func (h *GameHandler) UpdatePlatform(c *gin.Context) {
+	id, err := parseID(c, "id")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var platform models.Platform
+	err = c.ShouldBindJSON(&platform)
+	if err != nil {
+		_ = c.Error(invalidRequest(err))
+		return
+	}
+
+	platform.ID = id
+
+	err = h.service.UpdatePlatform(c.Request.Context(), &platform)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, platform)
+}
+
+func (h *GameHandler) DeletePlatform(c *gin.Context) {
+	id, err := parseID(c, "id")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	cascade, err := parseBoolQuery(c, "cascade")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	err = h.service.DeletePlatform(c.Request.Context(), id, cascade)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Platform deleted successfully"})
+}
+
+func (h *GameHandler) MergePlatform(c *gin.Context) {
+	id, err := parseID(c, "id")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var req mergeRequest
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		_ = c.Error(invalidRequest(err))
+		return
+	}
+
+	platform, err := h.service.MergePlatforms(c.Request.Context(), id, req.TargetID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, platform)
+}
+
+func (h *GameHandler) CreateCompany(c *gin.Context) {
+	var company models.Company
+
+	err := c.ShouldBindJSON(&company)
+	if err != nil {
+		_ = c.Error(invalidRequest(err))
+		return
+	}
+
+	err = h.service.CreateCompany(c.Request.Context(), &company)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, company)
+}
+
+func (h *GameHandler) GetAllCompanies(c *gin.Context) {
+	companies, err := h.service.GetAllCompanies(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, companies)
+}
+
+func (h *GameHandler) GetCompany(c *gin.Context) {
+	id, err := parseID(c, "id")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	company, err := h.service.GetCompanyByID(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, company)
+}
+
+func (h *GameHandler) UpdateCompany(c *gin.Context) {
+	id, err := parseID(c, "id")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var company models.Company
+	err = c.ShouldBindJSON(&company)
+	if err != nil {
+		_ = c.Error(invalidRequest(err))
+		return
+	}
+
+	company.ID = id
+
+	err = h.service.UpdateCompany(c.Request.Context(), &company)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, company)
+}
+
+func (h *GameHandler) DeleteCompany(c *gin.Context) {
+	id, err := parseID(c, "id")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	cascade, err := parseBoolQuery(c, "cascade")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	err = h.service.DeleteCompany(c.Request.Context(), id, cascade)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Company deleted successfully"})
+}
+
+func (h *GameHandler) CreateFranchise(c *gin.Context) {
+	var franchise models.Franchise
+
+	err := c.ShouldBindJSON(&franchise)
+	if err != nil {
+		_ = c.Error(invalidRequest(err))
+		return
+	}
+
+	err = h.service.CreateFranchise(c.Request.Context(), &franchise)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, franchise)
+}
+
+func (h *GameHandler) GetAllFranchises(c *gin.Context) {
+	franchises, err := h.service.GetAllFranchises(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, franchises)
+}
+
+func (h *GameHandler) GetFranchise(c *gin.Context) {
+	id, err := parseID(c, "id")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	franchise, err := h.service.GetFranchiseByID(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, franchise)
+}
+
+func (h *GameHandler) UpdateFranchise(c *gin.Context) {
+	id, err := parseID(c, "id")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var franchise models.Franchise
+	err = c.ShouldBindJSON(&franchise)
+	if err != nil {
+		_ = c.Error(invalidRequest(err))
+		return
+	}
+
+	franchise.ID = id
+
+	err = h.service.UpdateFranchise(c.Request.Context(), &franchise)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, franchise)
+}
+
+func (h *GameHandler) DeleteFranchise(c *gin.Context) {
+	id, err := parseID(c, "id")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	err = h.service.DeleteFranchise(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Franchise deleted successfully"})
+}
+
+func (h *GameHandler) GetTags(c *gin.Context) {
+	tags, err := h.service.GetTags(c.Request.Context(), c.Query("status"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tags)
+}
+
+func (h *GameHandler) SuggestTag(c *gin.Context) {
+	var req tagRequest
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		_ = c.Error(invalidRequest(err))
+		return
+	}
+
+	tag, created, err := h.service.SuggestTag(c.Request.Context(), req.Name)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+	c.JSON(status, tag)
+}
+
+func (h *GameHandler) ApproveTag(c *gin.Context) {
+	id, err := parseID(c, "id")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	tag, err := h.service.ApproveTag(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tag)
+}
+
+func (h *GameHandler) RejectTag(c *gin.Context) {
+	id, err := parseID(c, "id")
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	tag, err := h.service.RejectTag(c.Request.Context(), id)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tag)
 }
@@ -5,18 +5,67 @@ import (
 )
 
 func (h *GameHandler) RegisterRoutes(router *gin.Engine) {
+	router.HandleMethodNotAllowed = true
+	router.NoRoute(NoRouteHandler)
+	router.NoMethod(NoMethodHandler)
+
 	catalog := router.Group("/api/v1/catalog")
+	catalog.Use(ErrorHandler(h.logger))
 	{
 		catalog.POST("/games", h.CreateGame)
-		catalog.GET("/games/:id", h.GetGame)
-		catalog.PUT("/games/:id", h.UpdateGame)
-		catalog.DELETE("/games/:id", h.DeleteGame)
+		catalog.GET("/games/autocomplete", h.AutocompleteGames)
+		catalog.GET("/games/by-external/:provider/:external_id", h.GetGameByExternalID)
+		catalog.GET("/games/:ref", h.GetGame)
+		catalog.PUT("/games/:ref", h.UpdateGame)
+		catalog.PATCH("/games/:ref", h.PatchGame)
+		catalog.DELETE("/games/:ref", h.DeleteGame)
+		catalog.POST("/games/:ref/restore", h.RestoreGame)
+		catalog.POST("/games/:ref/genres/:genre_id", h.AddGameGenre)
+		catalog.DELETE("/games/:ref/genres/:genre_id", h.RemoveGameGenre)
+		catalog.POST("/games/:ref/platforms/:platform_id", h.AddGamePlatform)
+		catalog.DELETE("/games/:ref/platforms/:platform_id", h.RemoveGamePlatform)
+		catalog.POST("/games/:ref/relations", h.AddGameRelation)
+		catalog.DELETE("/games/:ref/relations/:relation_id", h.RemoveGameRelation)
+		catalog.GET("/games/:ref/translations", h.GetGameTranslations)
+		catalog.PUT("/games/:ref/translations/:locale", h.SetGameTranslation)
+		catalog.DELETE("/games/:ref/translations/:locale", h.DeleteGameTranslation)
+		catalog.GET("/games/:ref/media", h.GetGameMedia)
+		catalog.POST("/games/:ref/media", h.AddGameMedia)
+		catalog.PUT("/games/:ref/media/order", h.ReorderGameMedia)
+		catalog.DELETE("/games/:ref/media/:media_id", h.RemoveGameMedia)
+		catalog.POST("/games/:ref/tags", h.VoteGameTag)
+		catalog.DELETE("/games/:ref/tags/:tag_id", h.RemoveGameTagVote)
 		catalog.GET("/games", h.ListGames)
 		
 		catalog.POST("/genres", h.CreateGenre)
 		catalog.GET("/genres", h.GetAllGenres)
+		catalog.GET("/genres/:id", h.GetGenre)
+		catalog.PUT("/genres/:id", h.UpdateGenre)
+		catalog.DELETE("/genres/:id", h.DeleteGenre)
+		catalog.POST("/genres/:id/merge", h.MergeGenre)
 		
 		catalog.POST("/platforms", h.CreatePlatform)
 		catalog.GET("/platforms", h.GetAllPlatforms)
+		catalog.GET("/platforms/:id", h.GetPlatform)
+		catalog.PUT("/platforms/:id", h.UpdatePlatform)
+		catalog.DELETE("/platforms/:id", h.DeletePlatform)
+		catalog.POST("/platforms/:id/merge", h.MergePlatform)
+		
+		catalog.POST("/companies", h.CreateCompany)
+		catalog.GET("/companies", h.GetAllCompanies)
+		catalog.GET("/companies/:id", h.GetCompany)
+		catalog.PUT("/companies/:id", h.UpdateCompany)
+		catalog.DELETE("/companies/:id", h.DeleteCompany)
+		
+		catalog.POST("/franchises", h.CreateFranchise)
+		catalog.GET("/franchises", h.GetAllFranchises)
+		catalog.GET("/franchises/:id", h.GetFranchise)
+		catalog.PUT("/franchises/:id", h.UpdateFranchise)
+		catalog.DELETE("/franchises/:id", h.DeleteFranchise)
+		
+		catalog.GET("/tags", h.GetTags)
+		catalog.POST("/tags", h.SuggestTag)
+		catalog.POST("/tags/:id/approve", h.ApproveTag)
+		catalog.POST("/tags/:id/reject", h.RejectTag)
 	}
 }
\ No newline at end of file
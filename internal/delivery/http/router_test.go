@@ -0,0 +1,63 @@
+package http
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRouter(handler *GameHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	handler.RegisterRoutes(router)
+	return router
+}
+
+func quietLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return logger
+}
+
+func decodeProblem(t *testing.T, recorder *httptest.ResponseRecorder) Problem {
+	t.Helper()
+	assert.Equal(t, problemContentType, recorder.Header().Get("Content-Type"))
+
+	var problem Problem
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &problem), recorder.Body.String())
+	return problem
+}
+
+func TestUnknownRoutesReturnProblems(t *testing.T) {
+	router := newTestRouter(NewGameHandler(nil, quietLogger()))
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		status int
+		typ    string
+	}{
+		{"route inconnue", http.MethodGet, "/api/v1/catalog/unknown?x=1", http.StatusNotFound, "/problems/not-found"},
+		{"méthode non prise en charge", http.MethodPatch, "/api/v1/catalog/genres", http.StatusMethodNotAllowed, "/problems/method-not-allowed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(tt.method, tt.path, nil))
+
+			assert.Equal(t, tt.status, recorder.Code)
+			problem := decodeProblem(t, recorder)
+			assert.Equal(t, tt.status, problem.Status)
+			assert.Equal(t, tt.typ, problem.Type)
+			assert.Equal(t, tt.path, problem.Instance)
+		})
+	}
+}
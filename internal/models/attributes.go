@@ -0,0 +1,34 @@
+package models
+
+// Modes de jeu.
+const (
+	ModeSinglePlayer      = "single_player"
+	ModeLocalMultiplayer  = "local_multiplayer"
+	ModeOnlineMultiplayer = "online_multiplayer"
+	ModeLocalCoop         = "local_coop"
+	ModeOnlineCoop        = "online_coop"
+	ModeMMO               = "mmo"
+)
+
+// Fonctions d'accessibilité.
+const (
+	AccessibilityColorblindMode     = "colorblind_mode"
+	AccessibilityClosedCaptions     = "closed_captions"
+	AccessibilityRemappableControls = "remappable_controls"
+	AccessibilityDifficultyOptions  = "difficulty_options"
+	AccessibilityTextScaling        = "text_scaling"
+	AccessibilityScreenReader       = "screen_reader"
+	AccessibilityHighContrast       = "high_contrast"
+	AccessibilityReducedMotion      = "reduced_motion"
+)
+
+// GameLanguage indique comment une langue (BCP 47) est prise en charge par un
+// jeu : dans l'interface, en audio et/ou en sous-titres.
+type GameLanguage struct {
+	ID        uint   `json:"-" gorm:"primaryKey"`
+	GameID    uint   `json:"-" gorm:"not null;uniqueIndex:idx_game_language"`
+	Language  string `json:"language" gorm:"size:35;not null;uniqueIndex:idx_game_language" validate:"required,max=35" label:"la langue"`
+	Interface bool   `json:"interface"`
+	Audio     bool   `json:"audio"`
+	Subtitles bool   `json:"subtitles"`
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Company est un studio de développement ou un éditeur, partagé entre les
+// jeux qu'il a développés ou édités.
+type Company struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	Name      string    `json:"name" gorm:"size:255;not null" validate:"required,max=255" label:"le nom de la société"`
+	Key       string    `json:"-" gorm:"size:255;not null;uniqueIndex"`
+	Website   string    `json:"website,omitempty" gorm:"size:255" validate:"omitempty,url,max=255" label:"le site web de la société"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// legalSuffixes sont les formes juridiques ignorées lors de la comparaison de
+// deux noms de société.
+var legalSuffixes = map[string]bool{
+	"co": true, "company": true, "corp": true, "corporation": true, "inc": true,
+	"incorporated": true, "llc": true, "ltd": true, "limited": true, "gmbh": true,
+	"sa": true, "sas": true, "sarl": true, "kk": true, "ab": true, "plc": true,
+}
+
+// CompanyKey retourne la clé de dédoublonnage d'un nom de société : en
+// minuscules, sans ponctuation ni forme juridique finale, de sorte que
+// "Nintendo", "nintendo" et "Nintendo Co., Ltd." partagent la même clé.
+func CompanyKey(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for len(words) > 1 && legalSuffixes[words[len(words)-1]] {
+		words = words[:len(words)-1]
+	}
+
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,21 @@
+package models
+
+// Fournisseurs d'identifiants externes.
+const (
+	ProviderSteam = "steam"
+	ProviderIGDB  = "igdb"
+	ProviderPSN   = "psn"
+	ProviderEShop = "eshop"
+	ProviderXbox  = "xbox"
+	ProviderGOG   = "gog"
+	ProviderEpic  = "epic"
+)
+
+// GameExternalID associe un jeu à son identifiant chez un fournisseur (app ID
+// Steam, ID IGDB...). Un identifiant externe ne désigne qu'un seul jeu.
+type GameExternalID struct {
+	ID         uint   `json:"-" gorm:"primaryKey"`
+	GameID     uint   `json:"-" gorm:"not null;index"`
+	Provider   string `json:"provider" gorm:"size:20;not null;uniqueIndex:idx_external_id" validate:"required,oneof=steam igdb psn eshop xbox gog epic" label:"le fournisseur"`
+	ExternalID string `json:"external_id" gorm:"size:100;not null;uniqueIndex:idx_external_id" validate:"required,max=100" label:"l'identifiant externe"`
+}
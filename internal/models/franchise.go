@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+// Franchise regroupe les jeux d'une même série.
+type Franchise struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	Name        string    `json:"name" gorm:"size:255;not null;uniqueIndex" validate:"required,max=255" label:"le nom de la franchise"`
+	Description string    `json:"description,omitempty" gorm:"type:text"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Types de relation entre deux jeux : une relation (game, related_game, type)
+// se lit « related_game est un(e) <type> de game ».
+const (
+	RelationDLC       = "dlc"
+	RelationExpansion = "expansion"
+	RelationRemaster  = "remaster"
+	RelationRemake    = "remake"
+	RelationPort      = "port"
+	RelationBundle    = "bundle"
+	RelationSequel    = "sequel"
+)
+
+// GameRelation est une relation typée et orientée entre deux jeux.
+type GameRelation struct {
+	ID            uint      `json:"id" gorm:"primaryKey"`
+	GameID        uint      `json:"game_id" gorm:"not null;uniqueIndex:idx_game_relation"`
+	RelatedGameID uint      `json:"related_game_id" gorm:"not null;uniqueIndex:idx_game_relation;index" validate:"required" label:"le jeu lié"`
+	Type          string    `json:"type" gorm:"size:20;not null;uniqueIndex:idx_game_relation" validate:"required,oneof=dlc expansion remaster remake port bundle sequel" label:"le type de relation"`
+	Game          *Game     `json:"game,omitempty" gorm:"constraint:OnDelete:CASCADE" validate:"-"`
+	RelatedGame   *Game     `json:"related_game,omitempty" gorm:"constraint:OnDelete:CASCADE" validate:"-"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// RelatedGames rassemble les jeux liés à un jeu donné, pour les pages de
+// détail (« Dans la même série », « DLC disponibles »...).
+type RelatedGames struct {
+	// Series contient les autres jeux de la même franchise.
+	Series []Game `json:"series"`
+	// Relations sont les relations partant du jeu (ses DLC, remasters...).
+	Relations []GameRelation `json:"relations"`
+	// InverseRelations sont les relations aboutissant au jeu (par exemple le
+	// jeu de base d'un DLC).
+	InverseRelations []GameRelation `json:"inverse_relations"`
+}
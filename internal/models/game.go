@@ -2,48 +2,241 @@ package models
 
 import (
 	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
 )
 
 type Game struct {
-	ID            uint       `json:"id" gorm:"primaryKey"`
-	Title         string     `json:"title" gorm:"size:255;not null;index"`
-	Description   string     `json:"description" gorm:"type:text"`
-	Developer     string     `json:"developer" gorm:"size:255"`
-	Publisher     string     `json:"publisher" gorm:"size:255"`
-	ReleaseDate   time.Time  `json:"release_date"`
-	Genres        []Genre    `json:"genres" gorm:"many2many:game_genres;"`
-	Platforms     []Platform `json:"platforms" gorm:"many2many:game_platforms;"`
-	ImageURL      string     `json:"image_url" gorm:"size:255"`
-	AverageRating float64    `json:"average_rating" gorm:"type:decimal(3,2)"`
+	ID            uint            `json:"id" gorm:"primaryKey"`
+	Title         string          `json:"title" gorm:"size:255;not null;index" validate:"required,max=255" label:"le titre du jeu"`
+	Description   string          `json:"description" gorm:"type:text"`
+	Developer     string          `json:"developer" gorm:"size:255" validate:"max=255" label:"le développeur"`
+	Publisher     string          `json:"publisher" gorm:"size:255" validate:"max=255" label:"l'éditeur"`
+	ReleaseDate   time.Time       `json:"release_date" validate:"release_date" label:"la date de sortie"`
+	Genres        []Genre         `json:"genres" gorm:"many2many:game_genres;"`
+	Platforms     []Platform      `json:"platforms" gorm:"many2many:game_platforms;"`
+	GenreIDs      []uint          `json:"genre_ids,omitempty" gorm:"-"`
+	PlatformIDs   []uint          `json:"platform_ids,omitempty" gorm:"-"`
+	Developers    []Company       `json:"developers" gorm:"many2many:game_developers;"`
+	Publishers    []Company       `json:"publishers" gorm:"many2many:game_publishers;"`
+	DeveloperIDs  []uint          `json:"developer_ids,omitempty" gorm:"-"`
+	PublisherIDs  []uint          `json:"publisher_ids,omitempty" gorm:"-"`
+	FranchiseID   *uint           `json:"franchise_id,omitempty" gorm:"index"`
+	Franchise     *Franchise      `json:"franchise,omitempty" gorm:"constraint:OnDelete:SET NULL" validate:"-"`
+	ImageURL      string          `json:"image_url" gorm:"size:255" validate:"omitempty,url,max=255" label:"l'URL de l'image"`
+	AverageRating float64         `json:"average_rating" gorm:"type:decimal(3,2)" validate:"gte=0,lte=5" label:"la note moyenne"`
+	Price         decimal.Decimal `json:"price" gorm:"type:numeric(12,2);not null;default:0;index" validate:"gte=0" label:"le prix"`
+	Currency      string          `json:"currency" gorm:"size:3;not null;default:EUR" validate:"omitempty,iso4217" label:"la devise"`
+	Prices        []GamePrice     `json:"prices,omitempty" gorm:"foreignKey:GameID;constraint:OnDelete:CASCADE" validate:"dive"`
+	Releases      []GameRelease   `json:"releases,omitempty" gorm:"foreignKey:GameID;constraint:OnDelete:CASCADE" validate:"dive"`
+	AgeRatings    []AgeRating     `json:"age_ratings,omitempty" gorm:"foreignKey:GameID;constraint:OnDelete:CASCADE" validate:"dive"`
+	CreatedAt     time.Time       `json:"created_at"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+	DeletedAt     gorm.DeletedAt  `json:"deleted_at,omitempty" gorm:"index"`
+
+	// Configurations minimale et recommandée, par plateforme.
+	SystemRequirements []SystemRequirements `json:"system_requirements,omitempty" gorm:"foreignKey:GameID;constraint:OnDelete:CASCADE" validate:"dive"`
+	// Tags approuvés, par nombre de votes décroissant ; gérés par les votes
+	// (POST /games/:id/tags), jamais par l'écriture du jeu.
+	Tags []GameTag `json:"tags,omitempty" gorm:"foreignKey:GameID;constraint:OnDelete:CASCADE" validate:"-"`
+
+	// Langues prises en charge, modes de jeu, nombre de joueurs (0 si inconnu)
+	// et fonctions d'accessibilité.
+	Languages     []GameLanguage `json:"languages,omitempty" gorm:"foreignKey:GameID;constraint:OnDelete:CASCADE" validate:"dive"`
+	GameModes     []string       `json:"game_modes,omitempty" gorm:"type:jsonb;serializer:json" validate:"dive,oneof=single_player local_multiplayer online_multiplayer local_coop online_coop mmo" label:"le mode de jeu"`
+	MinPlayers    int            `json:"min_players,omitempty" gorm:"not null;default:0" validate:"gte=0" label:"le nombre minimum de joueurs"`
+	MaxPlayers    int            `json:"max_players,omitempty" gorm:"not null;default:0" validate:"gte=0" label:"le nombre maximum de joueurs"`
+	Accessibility []string       `json:"accessibility,omitempty" gorm:"type:jsonb;serializer:json" validate:"dive,oneof=colorblind_mode closed_captions remappable_controls difficulty_options text_scaling screen_reader high_contrast reduced_motion" label:"la fonction d'accessibilité"`
+
+	// Identifiants du jeu chez les fournisseurs tiers (Steam, IGDB...).
+	ExternalIDs []GameExternalID `json:"external_ids,omitempty" gorm:"foreignKey:GameID;constraint:OnDelete:CASCADE" validate:"dive"`
+
+	// Identifiants publics : UUID immuable attribué par la base à la création
+	// et slug dérivé du titre, tous deux gérés par le service. Les anciens
+	// slugs sont conservés dans GameSlug.
+	UUID string `json:"uuid" gorm:"type:uuid;not null;default:gen_random_uuid();uniqueIndex"`
+	Slug string `json:"slug" gorm:"size:120;uniqueIndex"`
+
+	// Champs calculés lors d'une recherche plein texte, jamais persistés.
+	Relevance            float64 `json:"relevance,omitempty" gorm:"->;-:migration"`
+	TitleHighlight       string  `json:"title_highlight,omitempty" gorm:"->;-:migration"`
+	DescriptionHighlight string  `json:"description_highlight,omitempty" gorm:"->;-:migration"`
+	TitleSimilarity      float64 `json:"title_similarity,omitempty" gorm:"->;-:migration"`
+
+	// Jeux liés, intégrés à la demande (include=related), jamais persistés.
+	Related *RelatedGames `json:"related,omitempty" gorm:"-"`
+
+	// Locale est la langue dans laquelle Title et Description sont servis,
+	// renseignée quand la réponse a été localisée.
+	Locale string `json:"locale,omitempty" gorm:"-"`
+	// Traductions du jeu, intégrées à la demande (include=translations).
+	Translations []GameTranslation `json:"translations,omitempty" gorm:"-"`
+	// Galerie du jeu, intégrée à la demande (include=media).
+	Media []GameMedia `json:"media,omitempty" gorm:"-"`
+}
+
+// GamePrice est un prix spécifique à une plateforme et/ou une région, qui
+// remplace le prix de base du jeu. Un jeu n'a qu'un prix par plateforme (ou
+// pour toutes) et par région (voir migrations.uniqueScopes).
+type GamePrice struct {
+	ID         uint            `json:"id" gorm:"primaryKey"`
+	GameID     uint            `json:"-" gorm:"not null;index"`
+	PlatformID *uint           `json:"platform_id,omitempty"`
+	Region     string          `json:"region,omitempty" gorm:"size:8" validate:"max=8" label:"la région"`
+	Amount     decimal.Decimal `json:"amount" gorm:"type:numeric(12,2);not null" validate:"gte=0" label:"le montant"`
+	Currency   string          `json:"currency" gorm:"size:3;not null" validate:"required,iso4217" label:"la devise"`
+}
+
+// Précisions d'une date de sortie : la date stockée est le premier jour de la
+// période (par exemple le 1er juillet 2026 pour « T3 2026 »).
+const (
+	PrecisionDay     = "day"
+	PrecisionMonth   = "month"
+	PrecisionQuarter = "quarter"
+	PrecisionYear    = "year"
+)
+
+// Statuts d'une sortie.
+const (
+	ReleaseAnnounced = "announced"
+	ReleaseDelayed   = "delayed"
+	ReleaseReleased  = "released"
+	ReleaseCancelled = "cancelled"
+)
+
+// GameRelease est la sortie d'un jeu sur une plateforme et/ou dans une région.
+// Sans plateforme ni région, elle s'applique à toutes. Un jeu n'a qu'une
+// sortie par plateforme et par région (voir migrations.uniqueScopes).
+type GameRelease struct {
+	ID         uint       `json:"id" gorm:"primaryKey"`
+	GameID     uint       `json:"-" gorm:"not null;index"`
+	PlatformID *uint      `json:"platform_id,omitempty" gorm:"index"`
+	Region     string     `json:"region,omitempty" gorm:"size:8" validate:"max=8" label:"la région"`
+	Date       *time.Time `json:"date,omitempty" gorm:"type:date;index" validate:"omitempty,release_date" label:"la date de sortie"`
+	Precision  string     `json:"precision" gorm:"size:10;not null;default:day" validate:"omitempty,oneof=day month quarter year" label:"la précision de la date"`
+	Status     string     `json:"status" gorm:"size:20;not null;default:announced;index" validate:"omitempty,oneof=announced delayed released cancelled" label:"le statut de la sortie"`
+	Platform   *Platform  `json:"-" gorm:"constraint:OnDelete:CASCADE" validate:"-"`
+}
+
+// AgeRating est la classification d'un jeu par un organisme (PEGI, ESRB...),
+// avec l'âge minimum correspondant et les descripteurs de contenu.
+type AgeRating struct {
+	ID          uint     `json:"id" gorm:"primaryKey"`
+	GameID      uint     `json:"-" gorm:"not null;uniqueIndex:idx_game_rating_board"`
+	Board       string   `json:"board" gorm:"size:10;not null;uniqueIndex:idx_game_rating_board" validate:"required,oneof=PEGI ESRB USK CERO" label:"l'organisme de classification"`
+	Rating      string   `json:"rating" gorm:"size:10;not null" validate:"required,max=10" label:"la classification"`
+	MinimumAge  int      `json:"minimum_age" gorm:"not null;index"`
+	Descriptors []string `json:"descriptors,omitempty" gorm:"type:jsonb;serializer:json" validate:"dive,max=50" label:"le descripteur de contenu"`
 }
 
 type Genre struct {
-	ID   uint   `json:"id" gorm:"primaryKey"`
-	Name string `json:"name" gorm:"size:100;not null;uniqueIndex"`
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	Name      string    `json:"name" gorm:"size:100;not null;uniqueIndex" validate:"required,max=100" label:"le nom du genre"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 type Platform struct {
-	ID   uint   `json:"id" gorm:"primaryKey"`
-	Name string `json:"name" gorm:"size:100;not null;uniqueIndex"`
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	Name      string    `json:"name" gorm:"size:100;not null;uniqueIndex" validate:"required,max=100" label:"le nom de la plateforme"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 type GameFilter struct {
-	Title     string   `form:"title"`
-	Developer string   `form:"developer"`
-	Publisher string   `form:"publisher"`
-	Genres    []string `form:"genres"`
-	Platforms []string `form:"platforms"`
-	MinRating *float64 `form:"min_rating"`
-	SortBy    string   `form:"sort_by"`
-	SortOrder string   `form:"sort_order"`
-	Page      int      `form:"page" default:"1"`
-	PageSize  int      `form:"page_size" default:"10"`
+	Query              string     `form:"q"`
+	Fuzzy              bool       `form:"fuzzy"`
+	Title              string     `form:"title"`
+	Developer          string     `form:"developer"`
+	Publisher          string     `form:"publisher"`
+	Genres             []string   `form:"genres"`
+	GenreIDs           []uint     `form:"genre_ids"`
+	GenreMatch         string     `form:"genre_match" validate:"omitempty,oneof=all any" label:"le mode de correspondance des genres"`
+	ExcludeGenres      []string   `form:"exclude_genres"`
+	ExcludeGenreIDs    []uint     `form:"exclude_genre_ids"`
+	Platforms          []string   `form:"platforms"`
+	PlatformIDs        []uint     `form:"platform_ids"`
+	PlatformMatch      string     `form:"platform_match" validate:"omitempty,oneof=all any" label:"le mode de correspondance des plateformes"`
+	ExcludePlatforms   []string   `form:"exclude_platforms"`
+	ExcludePlatformIDs []uint     `form:"exclude_platform_ids"`
+	DeveloperIDs       []uint     `form:"developer_ids"`
+	PublisherIDs       []uint     `form:"publisher_ids"`
+	CompanyIDs         []uint     `form:"company_ids"`
+	ReleasePlatformID  *uint      `form:"release_platform_id"`
+	ReleaseRegion      string     `form:"release_region" validate:"max=8" label:"la région de sortie"`
+	ReleasedAfter      *time.Time `form:"released_after" time_format:"2006-01-02"`
+	ReleasedBefore     *time.Time `form:"released_before" time_format:"2006-01-02"`
+	ReleaseStatus      string     `form:"release_status" validate:"omitempty,oneof=announced delayed released cancelled" label:"le statut de sortie"`
+	Upcoming           bool       `form:"upcoming"`
+	MaxAge             *int       `form:"max_age" validate:"omitempty,gte=0" label:"l'âge maximum"`
+	RatingBoard        string     `form:"rating_board" validate:"omitempty,oneof=PEGI ESRB USK CERO" label:"l'organisme de classification"`
+	MaxMemoryGB        *int       `form:"max_memory_gb" validate:"omitempty,gte=1" label:"la mémoire vive disponible"`
+	MaxStorageGB       *int       `form:"max_storage_gb" validate:"omitempty,gte=1" label:"l'espace disque disponible"`
+	Tags               []string   `form:"tags"`
+	TagMatch           string     `form:"tag_match" validate:"omitempty,oneof=all any" label:"le mode de correspondance des tags"`
+	MinRating          *float64   `form:"min_rating"`
+	MinPrice           *float64   `form:"min_price"`
+	MaxPrice           *float64   `form:"max_price"`
+	Currency           string     `form:"currency"`
+	IncludeDeleted     bool       `form:"include_deleted"`
+	SortBy             string     `form:"sort_by"`
+	SortOrder          string     `form:"sort_order"`
+	Page               int        `form:"page" default:"1"`
+	PageSize           int        `form:"page_size" default:"10"`
+	Cursor             string     `form:"cursor"`
+	Facets             bool       `form:"facets"`
+
+	// Langues (une langue de base comme « fr » couvre ses variantes régionales),
+	// modes de jeu et fonctions d'accessibilité, tous exigés, et nombre de
+	// joueurs à accueillir.
+	InterfaceLanguages []string `form:"interface_languages"`
+	AudioLanguages     []string `form:"audio_languages"`
+	SubtitleLanguages  []string `form:"subtitle_languages"`
+	GameModes          []string `form:"game_modes" validate:"dive,oneof=single_player local_multiplayer online_multiplayer local_coop online_coop mmo" label:"le mode de jeu"`
+	Players            *int     `form:"players" validate:"omitempty,gte=1" label:"le nombre de joueurs"`
+	Accessibility      []string `form:"accessibility" validate:"dive,oneof=colorblind_mode closed_captions remappable_controls difficulty_options text_scaling screen_reader high_contrast reduced_motion" label:"la fonction d'accessibilité"`
+	// Jeux sans identifiant chez ce fournisseur.
+	MissingExternalID string `form:"missing_external_id" validate:"omitempty,oneof=steam igdb psn eshop xbox gog epic" label:"le fournisseur"`
+}
+
+// Modes de correspondance des filtres par genre ou par plateforme.
+const (
+	MatchAny = "any"
+	MatchAll = "all"
+)
+
+// TitleSuggestion est une proposition d'autocomplétion sur le titre d'un jeu.
+type TitleSuggestion struct {
+	ID         uint    `json:"id"`
+	Title      string  `json:"title"`
+	Similarity float64 `json:"similarity"`
 }
 
 type GameResponse struct {
-	Games      []Game `json:"games"`
-	TotalCount int64  `json:"total_count"`
-	Page       int    `json:"page"`
-	PageSize   int    `json:"page_size"`
-	TotalPages int    `json:"total_pages"`
+	Games      []Game      `json:"games"`
+	TotalCount int64       `json:"total_count"`
+	Page       int         `json:"page"`
+	PageSize   int         `json:"page_size"`
+	TotalPages int         `json:"total_pages"`
+	NextCursor string      `json:"next_cursor,omitempty"`
+	Facets     *GameFacets `json:"facets,omitempty"`
+}
+
+// FacetCount est le nombre de jeux qui correspondraient au filtre courant pour
+// une valeur de facette donnée.
+type FacetCount struct {
+	ID    uint   `json:"id,omitempty"`
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+// GameFacets regroupe les compteurs par valeur de chaque critère filtrable.
+type GameFacets struct {
+	Genres     []FacetCount `json:"genres"`
+	Platforms  []FacetCount `json:"platforms"`
+	Developers []FacetCount `json:"developers"`
+	Publishers []FacetCount `json:"publishers"`
+	Ratings    []FacetCount `json:"ratings"`
+	Tags       []FacetCount `json:"tags"`
 }
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// Types de média d'un jeu.
+const (
+	MediaCover      = "cover"
+	MediaScreenshot = "screenshot"
+	MediaTrailer    = "trailer"
+	MediaLogo       = "logo"
+)
+
+// GameMedia est un visuel ou une vidéo de la galerie d'un jeu. Les médias sont
+// ordonnés par Position ; au plus un média par type est marqué principal (la
+// jaquette affichée, le trailer mis en avant...).
+type GameMedia struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	GameID    uint      `json:"game_id" gorm:"not null;index;uniqueIndex:idx_game_media_primary,where:is_primary"`
+	Type      string    `json:"type" gorm:"size:20;not null;uniqueIndex:idx_game_media_primary,where:is_primary" validate:"required,oneof=cover screenshot trailer logo" label:"le type de média"`
+	URL       string    `json:"url" gorm:"type:text;not null" validate:"required,url" label:"l'URL du média"`
+	Caption   string    `json:"caption,omitempty" gorm:"size:255" validate:"max=255" label:"la légende"`
+	Width     int       `json:"width,omitempty" validate:"gte=0" label:"la largeur"`
+	Height    int       `json:"height,omitempty" validate:"gte=0" label:"la hauteur"`
+	Position  int       `json:"position" gorm:"not null;default:0"`
+	Primary   bool      `json:"primary" gorm:"column:is_primary;not null;default:false"`
+	Game      *Game     `json:"-" gorm:"constraint:OnDelete:CASCADE" validate:"-"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,23 @@
+package models
+
+// Niveaux de configuration requise.
+const (
+	RequirementsMinimum     = "minimum"
+	RequirementsRecommended = "recommended"
+)
+
+// SystemRequirements est la configuration minimale ou recommandée d'un jeu sur
+// une plateforme (Windows, macOS, Linux...). Les quantités sont en mégaoctets ;
+// 0 signifie « non renseigné ».
+type SystemRequirements struct {
+	ID             uint   `json:"id" gorm:"primaryKey"`
+	GameID         uint   `json:"-" gorm:"not null;uniqueIndex:idx_game_requirements_scope"`
+	PlatformID     uint   `json:"platform_id" gorm:"not null;uniqueIndex:idx_game_requirements_scope;index" validate:"required" label:"la plateforme"`
+	Tier           string `json:"tier" gorm:"size:20;not null;uniqueIndex:idx_game_requirements_scope" validate:"required,oneof=minimum recommended" label:"le niveau de configuration"`
+	OS             string `json:"os,omitempty" gorm:"size:100" validate:"max=100" label:"le système d'exploitation"`
+	CPU            string `json:"cpu,omitempty" gorm:"size:255" validate:"max=255" label:"le processeur"`
+	GPU            string `json:"gpu,omitempty" gorm:"size:255" validate:"max=255" label:"la carte graphique"`
+	MemoryMB       int    `json:"memory_mb,omitempty" gorm:"not null;default:0" validate:"gte=0" label:"la mémoire vive"`
+	StorageMB      int    `json:"storage_mb,omitempty" gorm:"not null;default:0" validate:"gte=0" label:"l'espace disque"`
+	DirectXVersion string `json:"directx_version,omitempty" gorm:"size:10" validate:"max=10" label:"la version de DirectX"`
+}
@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// GameSlug conserve un ancien slug d'un jeu, pour que les URL publiées avant
+// un changement de titre redirigent vers le slug courant.
+type GameSlug struct {
+	ID        uint      `json:"-" gorm:"primaryKey"`
+	GameID    uint      `json:"-" gorm:"not null;index"`
+	Slug      string    `json:"slug" gorm:"size:120;not null;uniqueIndex"`
+	Game      *Game     `json:"-" gorm:"constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,48 @@
+package models
+
+import "time"
+
+// Statuts de modération d'un tag. Seuls les tags approuvés sont affichés sur
+// les jeux et utilisables comme filtre.
+const (
+	TagPending  = "pending"
+	TagApproved = "approved"
+	TagRejected = "rejected"
+)
+
+// Tag est un mot-clé proposé par les joueurs (« roguelike », « co-op »...),
+// soumis à modération. Son nom est normalisé en minuscules.
+type Tag struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	Name      string    `json:"name" gorm:"size:50;not null;uniqueIndex" validate:"required,max=50" label:"le nom du tag"`
+	Status    string    `json:"status" gorm:"size:20;not null;default:pending;index"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Popularité, calculée lors du listage des tags, jamais persistée.
+	GameCount int64 `json:"game_count" gorm:"->;-:migration"`
+	Votes     int64 `json:"votes" gorm:"->;-:migration"`
+}
+
+// GameTag associe un tag à un jeu, avec son nombre de votes, tenu à jour à
+// partir des votes enregistrés (voir GameTagVote).
+type GameTag struct {
+	GameID    uint      `json:"-" gorm:"primaryKey"`
+	TagID     uint      `json:"tag_id" gorm:"primaryKey;index"`
+	Votes     int       `json:"votes" gorm:"not null;default:1"`
+	Tag       *Tag      `json:"tag,omitempty" gorm:"constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	Voters []GameTagVote `json:"-" gorm:"foreignKey:GameID,TagID;references:GameID,TagID;constraint:OnDelete:CASCADE"`
+}
+
+// GameTagVote est le vote d'un votant pour un tag sur un jeu : chaque votant
+// ne compte qu'une fois par jeu et par tag. Voter est l'empreinte SHA-256 de
+// l'identifiant fourni par le client ou, à défaut, de son adresse IP.
+type GameTagVote struct {
+	GameID    uint   `gorm:"primaryKey"`
+	TagID     uint   `gorm:"primaryKey"`
+	Voter     string `gorm:"primaryKey;size:64"`
+	CreatedAt time.Time
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// GameTranslation est la traduction du titre et de la description d'un jeu
+// dans une langue. Un champ vide n'est pas traduit et retombe sur la langue
+// suivante de la chaîne de repli.
+type GameTranslation struct {
+	ID          uint      `json:"-" gorm:"primaryKey"`
+	GameID      uint      `json:"game_id" gorm:"not null;uniqueIndex:idx_game_translation_locale"`
+	Locale      string    `json:"locale" gorm:"size:35;not null;uniqueIndex:idx_game_translation_locale" validate:"required,max=35" label:"la langue"`
+	Title       string    `json:"title,omitempty" gorm:"size:255" validate:"max=255" label:"le titre"`
+	Description string    `json:"description,omitempty" gorm:"type:text"`
+	Game        *Game     `json:"-" gorm:"constraint:OnDelete:CASCADE" validate:"-"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
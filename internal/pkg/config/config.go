@@ -11,7 +11,10 @@ import (
 
 type Config struct {
 	Server   ServerConfig
+	GRPC     GRPCConfig
 	Database database.PostgresConfig
+	Search   SearchConfig
+	Locale   LocaleConfig
 	Logger   LoggerConfig
 }
 
@@ -22,6 +25,20 @@ type ServerConfig struct {
 	IdleTimeout  time.Duration
 }
 
+type GRPCConfig struct {
+	Port string
+}
+
+type SearchConfig struct {
+	Language string
+}
+
+// LocaleConfig.Default est la langue (BCP 47) dans laquelle sont rédigés les
+// titres et descriptions des jeux ; les autres langues passent par les traductions.
+type LocaleConfig struct {
+	Default string
+}
+
 type LoggerConfig struct {
 	Level string
 }
@@ -62,6 +79,8 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("server.writeTimeout", "10s")
 	v.SetDefault("server.idleTimeout", "120s")
 
+	v.SetDefault("grpc.port", "9090")
+
 	v.SetDefault("database.host", "localhost")
 	v.SetDefault("database.port", "5432")
 	v.SetDefault("database.user", "postgres")
@@ -69,6 +88,9 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("database.dbname", "gamecatalog")
 	v.SetDefault("database.sslmode", "disable")
 
+	v.SetDefault("search.language", "french")
+	v.SetDefault("locale.default", "fr")
+
 	v.SetDefault("logger.level", "info")
 }
 
@@ -0,0 +1,114 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+)
+
+type Code string
+
+const (
+	CodeNotFound   Code = "not_found"
+	CodeConflict   Code = "conflict"
+	CodeValidation Code = "validation"
+	CodeInternal   Code = "internal"
+)
+
+var (
+	ErrNotFound   = &Error{Code: CodeNotFound, Message: "ressource non trouvée"}
+	ErrConflict   = &Error{Code: CodeConflict, Message: "conflit avec une ressource existante"}
+	ErrValidation = &Error{Code: CodeValidation, Message: "données invalides"}
+	ErrInternal   = &Error{Code: CodeInternal, Message: "erreur interne"}
+)
+
+// FieldError décrit une violation portant sur un champ précis de la requête.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Error est une erreur métier typée, partagée entre le repository, le service
+// et les couches de livraison (HTTP, gRPC).
+type Error struct {
+	Code    Code
+	Message string
+	Fields  []FieldError
+	Err     error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is permet à errors.Is de comparer une erreur typée avec les sentinelles du
+// paquet en se basant uniquement sur le code.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+func NotFound(format string, args ...interface{}) error {
+	return &Error{Code: CodeNotFound, Message: fmt.Sprintf(format, args...)}
+}
+
+func Conflict(format string, args ...interface{}) error {
+	return &Error{Code: CodeConflict, Message: fmt.Sprintf(format, args...)}
+}
+
+func Validation(format string, args ...interface{}) error {
+	return &Error{Code: CodeValidation, Message: fmt.Sprintf(format, args...)}
+}
+
+// InvalidFields retourne une erreur de validation regroupant toutes les
+// violations détectées.
+func InvalidFields(message string, fields ...FieldError) error {
+	return &Error{Code: CodeValidation, Message: message, Fields: fields}
+}
+
+func Internal(err error) error {
+	return &Error{Code: CodeInternal, Message: ErrInternal.Message, Err: err}
+}
+
+func Wrap(code Code, err error, format string, args ...interface{}) error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...), Err: err}
+}
+
+// CodeOf retourne le code de la première erreur typée de la chaîne, ou
+// CodeInternal si l'erreur n'est pas typée.
+func CodeOf(err error) Code {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr.Code
+	}
+
+	return CodeInternal
+}
+
+// MessageOf retourne le message destiné au client, sans exposer les détails
+// des erreurs internes.
+func MessageOf(err error) string {
+	var appErr *Error
+	if errors.As(err, &appErr) && appErr.Code != CodeInternal {
+		return appErr.Message
+	}
+
+	return ErrInternal.Message
+}
+
+// FieldsOf retourne les violations par champ portées par l'erreur, s'il y en a.
+func FieldsOf(err error) []FieldError {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr.Fields
+	}
+
+	return nil
+}
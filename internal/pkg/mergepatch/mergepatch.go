@@ -0,0 +1,48 @@
+package mergepatch
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Apply applique un JSON Merge Patch (RFC 7396) sur le document original.
+func Apply(original, patch []byte) ([]byte, error) {
+	var target interface{}
+	if len(original) > 0 {
+		err := json.Unmarshal(original, &target)
+		if err != nil {
+			return nil, fmt.Errorf("document original invalide: %w", err)
+		}
+	}
+
+	var patchValue interface{}
+	err := json.Unmarshal(patch, &patchValue)
+	if err != nil {
+		return nil, fmt.Errorf("patch invalide: %w", err)
+	}
+
+	return json.Marshal(merge(target, patchValue))
+}
+
+func merge(target, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = map[string]interface{}{}
+	}
+
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+			continue
+		}
+
+		targetObject[key] = merge(targetObject[key], value)
+	}
+
+	return targetObject
+}
@@ -0,0 +1,142 @@
+package migrations
+
+import (
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// companyCredit est un nom de développeur ou d'éditeur saisi en texte libre
+// sur un jeu.
+type companyCredit struct {
+	GameID uint
+	Name   string
+}
+
+// companyRoles associe chaque colonne texte de games à sa table de liaison.
+var companyRoles = []struct {
+	column    string
+	joinTable string
+}{
+	{column: "developer", joinTable: "game_developers"},
+	{column: "publisher", joinTable: "game_publishers"},
+}
+
+// MigrateCompanies convertit les développeurs et éditeurs saisis en texte
+// libre en sociétés dédoublonnées (voir models.CompanyKey), puis lie les jeux
+// à ces sociétés. Elle n'est appliquée qu'une fois (voir runOnce) : rejouée à
+// chaque démarrage, elle relierait les sociétés retirées d'un jeu dont la
+// colonne texte a gardé l'ancienne valeur. Une base qui a déjà des sociétés
+// l'a appliquée avant l'introduction de applied_migrations et n'est pas
+// retraitée.
+func MigrateCompanies(db *gorm.DB, logger *logrus.Logger) error {
+	applied, err := runOnce(db, "companies_from_text_columns", func(tx *gorm.DB) error {
+		var companies int64
+		err := tx.Model(&models.Company{}).Count(&companies).Error
+		if err != nil || companies > 0 {
+			return err
+		}
+
+		return migrateCompanyCredits(tx, logger)
+	})
+	if err == nil && !applied {
+		logger.Info("Sociétés déjà migrées depuis les colonnes developer et publisher")
+	}
+	return err
+}
+
+// migrateCompanyCredits crée les sociétés et lie les jeux sans société pour
+// un rôle.
+func migrateCompanyCredits(tx *gorm.DB, logger *logrus.Logger) error {
+	credits := make(map[string][]companyCredit, len(companyRoles))
+	spellings := make(map[string]map[string]int)
+
+	for _, role := range companyRoles {
+		var roleCredits []companyCredit
+		err := tx.Table("games").
+			Select("games.id AS game_id, games." + role.column + " AS name").
+			Where("games." + role.column + " <> ''").
+			Where("NOT EXISTS (SELECT 1 FROM " + role.joinTable + " WHERE " + role.joinTable + ".game_id = games.id)").
+			Scan(&roleCredits).Error
+		if err != nil {
+			logger.WithError(err).Errorf("Erreur lors de la lecture des colonnes %s", role.column)
+			return err
+		}
+
+		credits[role.joinTable] = roleCredits
+		for _, credit := range roleCredits {
+			key := models.CompanyKey(credit.Name)
+			if spellings[key] == nil {
+				spellings[key] = make(map[string]int)
+			}
+			spellings[key][credit.Name]++
+		}
+	}
+
+	if len(spellings) == 0 {
+		return nil
+	}
+
+	companyIDs := make(map[string]uint, len(spellings))
+	for key, names := range spellings {
+		if key == "" {
+			continue
+		}
+
+		company := models.Company{Key: key, Name: preferredSpelling(names)}
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "key"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{"key": gorm.Expr("EXCLUDED.key")}),
+		}).Create(&company).Error
+		if err != nil {
+			logger.WithError(err).Errorf("Erreur lors de la création de la société %s", company.Name)
+			return err
+		}
+
+		companyIDs[key] = company.ID
+	}
+
+	links := 0
+	for _, role := range companyRoles {
+		for _, credit := range credits[role.joinTable] {
+			companyID, ok := companyIDs[models.CompanyKey(credit.Name)]
+			if !ok {
+				continue
+			}
+
+			err := tx.Exec(
+				"INSERT INTO "+role.joinTable+" (game_id, company_id) VALUES (?, ?) ON CONFLICT DO NOTHING",
+				credit.GameID, companyID,
+			).Error
+			if err != nil {
+				logger.WithError(err).Errorf("Erreur lors de la liaison des jeux dans %s", role.joinTable)
+				return err
+			}
+			links++
+		}
+	}
+
+	logger.WithFields(logrus.Fields{
+		"companies": len(companyIDs),
+		"links":     links,
+	}).Info("Sociétés migrées depuis les colonnes developer et publisher")
+	return nil
+}
+
+// preferredSpelling retourne l'orthographe la plus fréquente, la plus courte
+// en cas d'égalité.
+func preferredSpelling(names map[string]int) string {
+	best := ""
+	for name, count := range names {
+		switch {
+		case best == "",
+			count > names[best],
+			count == names[best] && len(name) < len(best),
+			count == names[best] && len(name) == len(best) && name < best:
+			best = name
+		}
+	}
+
+	return best
+}
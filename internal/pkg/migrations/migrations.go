@@ -1,8 +1,11 @@
 package migrations
 
 import (
+	"fmt"
+
 	"github.com/sirupsen/logrus"
 	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	"github.com/NNNACHID/api-game-catalog-cl/internal/pkg/slug"
 	"gorm.io/gorm"
 )
 
@@ -13,6 +16,28 @@ func RunMigrations(db *gorm.DB, logger *logrus.Logger) error {
 		&models.Game{},
 		&models.Genre{},
 		&models.Platform{},
+		&models.GamePrice{},
+		&models.GameRelease{},
+		&models.AgeRating{},
+		&models.Company{},
+		&models.Franchise{},
+		&models.GameRelation{},
+		&models.GameTranslation{},
+		&models.GameMedia{},
+		&models.SystemRequirements{},
+		&models.Tag{},
+		&models.GameTag{},
+		&models.GameTagVote{},
+		&models.GameLanguage{},
+		&models.GameExternalID{},
+		&models.GameSlug{},
+		&appliedMigration{},
+	}
+
+	err := removeOrphanReleases(db)
+	if err != nil {
+		logger.WithError(err).Error("Erreur lors du nettoyage des sorties orphelines")
+		return err
 	}
 
 	for _, model := range models {
@@ -26,10 +51,139 @@ func RunMigrations(db *gorm.DB, logger *logrus.Logger) error {
 		logger.Infof("Migration réussie pour %T", model)
 	}
 
+	err = backfillSlugs(db, logger)
+	if err != nil {
+		logger.WithError(err).Error("Erreur lors de l'attribution des slugs")
+		return err
+	}
+
+	err = uniqueScopes(db)
+	if err != nil {
+		logger.WithError(err).Error("Erreur lors de la création des index d'unicité par plateforme et région")
+		return err
+	}
+
+	err = collapseLegacyTagVotes(db)
+	if err != nil {
+		logger.WithError(err).Error("Erreur lors de la reprise des votes de tags")
+		return err
+	}
+
 	logger.Info("Migrations de base de données terminées avec succès")
 	return nil
 }
 
+// removeOrphanReleases supprime les sorties rattachées à une plateforme
+// disparue, qui empêcheraient la création de la clé étrangère platform_id.
+func removeOrphanReleases(db *gorm.DB) error {
+	if !db.Migrator().HasTable(&models.GameRelease{}) || !db.Migrator().HasTable(&models.Platform{}) {
+		return nil
+	}
+
+	return db.Exec("DELETE FROM game_releases WHERE platform_id IS NOT NULL AND platform_id NOT IN (SELECT id FROM platforms)").Error
+}
+
+// backfillSlugs attribue un slug aux jeux créés avant leur introduction, en
+// dédoublonnant par un suffixe numérique comme le fait le service.
+func backfillSlugs(db *gorm.DB, logger *logrus.Logger) error {
+	var games []models.Game
+	err := db.Unscoped().Select("id", "title").Where("slug IS NULL").Order("id").Find(&games).Error
+	if err != nil || len(games) == 0 {
+		return err
+	}
+
+	var existing []string
+	err = db.Raw("SELECT slug FROM games WHERE slug IS NOT NULL UNION SELECT slug FROM game_slugs").Scan(&existing).Error
+	if err != nil {
+		return err
+	}
+
+	taken := make(map[string]bool, len(existing)+len(games))
+	for _, value := range existing {
+		taken[value] = true
+	}
+
+	for _, game := range games {
+		base := slug.Make(game.Title)
+		candidate := base
+		for n := 2; taken[candidate]; n++ {
+			candidate = fmt.Sprintf("%s-%d", base, n)
+		}
+		taken[candidate] = true
+
+		err := db.Unscoped().Model(&models.Game{}).Where("id = ?", game.ID).UpdateColumn("slug", candidate).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	logger.Infof("Slugs attribués à %d jeux", len(games))
+	return nil
+}
+
+// scopedTables sont les tables dont les lignes s'appliquent à une plateforme
+// et à une région, une plateforme NULL valant pour toutes.
+var scopedTables = []struct {
+	table       string
+	legacyIndex string
+	index       string
+}{
+	{table: "game_prices", legacyIndex: "idx_game_price_scope", index: "idx_game_prices_scope"},
+	{table: "game_releases", legacyIndex: "idx_game_release_scope", index: "idx_game_releases_scope"},
+}
+
+// uniqueScopes impose une seule ligne par jeu, plateforme et région. Un index
+// unique sur platform_id laisserait passer les doublons « toutes plateformes »,
+// PostgreSQL 14 tenant les NULL pour distincts : l'index porte donc sur
+// COALESCE(platform_id, 0). Les doublons existants sont supprimés au profit
+// de la ligne la plus récente.
+func uniqueScopes(db *gorm.DB) error {
+	for _, scoped := range scopedTables {
+		_, err := runOnce(db, "unique_scopes_"+scoped.table, func(tx *gorm.DB) error {
+			statements := []string{
+				fmt.Sprintf(`DELETE FROM %[1]s duplicate WHERE EXISTS (
+					SELECT 1 FROM %[1]s kept
+					WHERE kept.game_id = duplicate.game_id AND kept.platform_id IS NOT DISTINCT FROM duplicate.platform_id
+					AND kept.region = duplicate.region AND kept.id > duplicate.id)`, scoped.table),
+				fmt.Sprintf(`DROP INDEX IF EXISTS %s`, scoped.legacyIndex),
+				fmt.Sprintf(`CREATE UNIQUE INDEX IF NOT EXISTS %s ON %s (game_id, COALESCE(platform_id, 0), region)`, scoped.index, scoped.table),
+			}
+
+			for _, statement := range statements {
+				err := tx.Exec(statement).Error
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collapseLegacyTagVotes reprend les associations jeu-tag antérieures aux
+// votes nominatifs : leur compteur, que n'importe qui pouvait gonfler, est
+// ramené à un unique vote « legacy », puis chaque compteur est recalculé à
+// partir des votes enregistrés.
+func collapseLegacyTagVotes(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(`INSERT INTO game_tag_votes (game_id, tag_id, voter, created_at)
+			SELECT game_id, tag_id, 'legacy', NOW() FROM game_tags
+			WHERE NOT EXISTS (SELECT 1 FROM game_tag_votes WHERE game_tag_votes.game_id = game_tags.game_id AND game_tag_votes.tag_id = game_tags.tag_id)
+			ON CONFLICT DO NOTHING`).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Exec(`UPDATE game_tags SET votes = counts.votes
+			FROM (SELECT game_id, tag_id, COUNT(*) AS votes FROM game_tag_votes GROUP BY game_id, tag_id) AS counts
+			WHERE counts.game_id = game_tags.game_id AND counts.tag_id = game_tags.tag_id AND game_tags.votes <> counts.votes`).Error
+	})
+}
+
 func SeedData(db *gorm.DB, logger *logrus.Logger) error {
 	var count int64
 	db.Model(&models.Genre{}).Count(&count)
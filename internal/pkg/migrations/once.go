@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// appliedMigration enregistre une migration de données déjà appliquée.
+type appliedMigration struct {
+	Name      string    `gorm:"primaryKey;size:100"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+func (appliedMigration) TableName() string {
+	return "applied_migrations"
+}
+
+// runOnce applique migrate dans une transaction qui enregistre aussi son nom,
+// et indique si elle a été appliquée. Une migration déjà enregistrée n'est pas
+// rejouée ; une migration en échec n'est pas enregistrée. Une instance lancée
+// en parallèle attend la fin de la transaction puis passe son tour.
+func runOnce(db *gorm.DB, name string, migrate func(tx *gorm.DB) error) (bool, error) {
+	applied := false
+	err := db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&appliedMigration{Name: name, AppliedAt: time.Now()})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		applied = true
+		return migrate(tx)
+	})
+	if err != nil {
+		return false, err
+	}
+	return applied, nil
+}
@@ -0,0 +1,106 @@
+package migrations
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strconv"
+	"sync"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// markerDriver est un pilote database/sql sans données dont chaque écriture
+// affecte le nombre de lignes indiqué par la chaîne de connexion : « 1 »
+// simule une première insertion du marqueur, « 0 » un marqueur existant.
+type markerDriver struct{}
+
+type markerConn struct{ rowsAffected int64 }
+
+type markerStmt struct{ rowsAffected int64 }
+
+type markerRows struct{}
+
+func (markerDriver) Open(dsn string) (driver.Conn, error) {
+	rowsAffected, err := strconv.ParseInt(dsn, 10, 64)
+	return markerConn{rowsAffected: rowsAffected}, err
+}
+
+func (c markerConn) Prepare(string) (driver.Stmt, error)     { return markerStmt(c), nil }
+func (markerConn) Close() error                              { return nil }
+func (c markerConn) Begin() (driver.Tx, error)               { return c, nil }
+func (markerConn) Commit() error                             { return nil }
+func (markerConn) Rollback() error                           { return nil }
+func (markerConn) CheckNamedValue(*driver.NamedValue) error  { return nil }
+func (markerStmt) Close() error                              { return nil }
+func (markerStmt) NumInput() int                             { return -1 }
+func (markerStmt) Query([]driver.Value) (driver.Rows, error) { return markerRows{}, nil }
+func (markerRows) Columns() []string                         { return nil }
+func (markerRows) Close() error                              { return nil }
+func (markerRows) Next([]driver.Value) error                 { return io.EOF }
+func (s markerStmt) Exec([]driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(s.rowsAffected), nil
+}
+
+var registerMarkerDriver sync.Once
+
+func newMarkerDB(t *testing.T, rowsAffected string) *gorm.DB {
+	t.Helper()
+	registerMarkerDriver.Do(func() { sql.Register("marker", markerDriver{}) })
+
+	conn, err := sql.Open("marker", rowsAffected)
+	if err != nil {
+		t.Fatalf("ouverture de la base de test : %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: conn}), &gorm.Config{
+		DisableAutomaticPing: true,
+		Logger:               logger.Discard,
+	})
+	if err != nil {
+		t.Fatalf("ouverture de la base de test : %v", err)
+	}
+	return db
+}
+
+func TestRunOnce(t *testing.T) {
+	t.Run("première exécution", func(t *testing.T) {
+		calls := 0
+		applied, err := runOnce(newMarkerDB(t, "1"), "test", func(*gorm.DB) error {
+			calls++
+			return nil
+		})
+
+		if err != nil || !applied || calls != 1 {
+			t.Errorf("runOnce = (%v, %v) après %d appels, attendu (true, nil) après 1", applied, err, calls)
+		}
+	})
+
+	t.Run("déjà appliquée", func(t *testing.T) {
+		calls := 0
+		applied, err := runOnce(newMarkerDB(t, "0"), "test", func(*gorm.DB) error {
+			calls++
+			return nil
+		})
+
+		if err != nil || applied || calls != 0 {
+			t.Errorf("runOnce = (%v, %v) après %d appels, attendu (false, nil) sans appel", applied, err, calls)
+		}
+	})
+
+	t.Run("échec propagé", func(t *testing.T) {
+		failure := errors.New("échec")
+		applied, err := runOnce(newMarkerDB(t, "1"), "test", func(*gorm.DB) error {
+			return failure
+		})
+
+		if !errors.Is(err, failure) || applied {
+			t.Errorf("runOnce = (%v, %v), attendu (false, %v)", applied, err, failure)
+		}
+	})
+}
@@ -0,0 +1,98 @@
+package migrations
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var searchLanguagePattern = regexp.MustCompile(`^[a-z_]+$`)
+
+// searchConfigPattern extrait les configurations utilisées par l'expression de
+// génération de search_vector, telle que la restitue pg_get_expr.
+var searchConfigPattern = regexp.MustCompile(`to_tsvector\('([a-z_]+)'::regconfig`)
+
+// SetupFullTextSearch ajoute la colonne tsvector pondérée (titre > développeur/éditeur
+// > description) et son index GIN sur la table des jeux. Si la colonne existe
+// déjà avec une autre configuration (search.language modifié depuis), elle est
+// recréée afin que l'index corresponde à la configuration des requêtes.
+func SetupFullTextSearch(db *gorm.DB, language string, logger *logrus.Logger) error {
+	if !searchLanguagePattern.MatchString(language) {
+		return fmt.Errorf("configuration de recherche invalide: %q", language)
+	}
+
+	logger.WithField("language", language).Info("Mise en place de la recherche plein texte")
+
+	var expressions []string
+	err := db.Raw(`SELECT pg_get_expr(d.adbin, d.adrelid) FROM pg_attrdef d
+		JOIN pg_attribute a ON a.attrelid = d.adrelid AND a.attnum = d.adnum
+		WHERE d.adrelid = 'games'::regclass AND a.attname = 'search_vector'`).Scan(&expressions).Error
+	if err != nil {
+		logger.WithError(err).Error("Erreur lors de la lecture de la colonne search_vector")
+		return err
+	}
+
+	var statements []string
+	if len(expressions) > 0 && !searchVectorUses(expressions[0], language) {
+		logger.WithField("language", language).Warn("Configuration de search_vector obsolète, reconstruction de la colonne")
+		statements = append(statements, `ALTER TABLE games DROP COLUMN search_vector`)
+	}
+	statements = append(statements,
+		fmt.Sprintf(`ALTER TABLE games ADD COLUMN IF NOT EXISTS search_vector tsvector
+			GENERATED ALWAYS AS (
+				setweight(to_tsvector('%[1]s', coalesce(title, '')), 'A') ||
+				setweight(to_tsvector('%[1]s', coalesce(developer, '') || ' ' || coalesce(publisher, '')), 'B') ||
+				setweight(to_tsvector('%[1]s', coalesce(description, '')), 'C')
+			) STORED`, language),
+		`CREATE INDEX IF NOT EXISTS idx_games_search_vector ON games USING GIN (search_vector)`,
+	)
+
+	for _, statement := range statements {
+		err := db.Exec(statement).Error
+		if err != nil {
+			logger.WithError(err).Error("Erreur lors de la mise en place de la recherche plein texte")
+			return err
+		}
+	}
+
+	return nil
+}
+
+// searchVectorUses indique si l'expression de génération de search_vector
+// n'utilise que la configuration language.
+func searchVectorUses(expression, language string) bool {
+	matches := searchConfigPattern.FindAllStringSubmatch(expression, -1)
+	if len(matches) == 0 {
+		return false
+	}
+
+	for _, match := range matches {
+		if match[1] != language {
+			return false
+		}
+	}
+	return true
+}
+
+// SetupFuzzySearch active pg_trgm et indexe les titres pour la recherche
+// tolérante aux fautes de frappe et l'autocomplétion.
+func SetupFuzzySearch(db *gorm.DB, logger *logrus.Logger) error {
+	logger.Info("Mise en place de la recherche approximative (pg_trgm)")
+
+	statements := []string{
+		`CREATE EXTENSION IF NOT EXISTS pg_trgm`,
+		`CREATE INDEX IF NOT EXISTS idx_games_title_trgm ON games USING GIN (lower(title) gin_trgm_ops)`,
+	}
+
+	for _, statement := range statements {
+		err := db.Exec(statement).Error
+		if err != nil {
+			logger.WithError(err).Error("Erreur lors de la mise en place de la recherche approximative")
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package migrations
+
+import "testing"
+
+// Expression telle que la restitue pg_get_expr pour la colonne générée.
+const frenchSearchVector = `((setweight(to_tsvector('french'::regconfig, (COALESCE(title, ''::character varying))::text), 'A'::"char") || ` +
+	`setweight(to_tsvector('french'::regconfig, (((COALESCE(developer, ''::character varying))::text || ' '::text) || (COALESCE(publisher, ''::character varying))::text)), 'B'::"char")) || ` +
+	`setweight(to_tsvector('french'::regconfig, COALESCE(description, ''::text)), 'C'::"char"))`
+
+func TestSearchVectorUses(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		language   string
+		want       bool
+	}{
+		{"même configuration", frenchSearchVector, "french", true},
+		{"configuration modifiée", frenchSearchVector, "english", false},
+		{"configurations mélangées", `to_tsvector('french'::regconfig, title) || to_tsvector('simple'::regconfig, description)`, "french", false},
+		{"expression inattendue", `to_tsvector(title)`, "french", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := searchVectorUses(tt.expression, tt.language)
+			if got != tt.want {
+				t.Errorf("searchVectorUses(%q) = %v, attendu %v", tt.language, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Package slug dérive des identifiants lisibles pour les URL à partir de
+// titres libres.
+package slug
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// MaxLength est la longueur maximale d'un slug, suffixe de dédoublonnage
+// compris.
+const MaxLength = 120
+
+// baseLength laisse la place d'un suffixe (« -2 », « -17 »...) sous MaxLength.
+const baseLength = MaxLength - 8
+
+// Make convertit un titre en slug : minuscules ASCII sans accents, mots
+// séparés par des tirets (« Pokémon Rouge & Bleu » donne « pokemon-rouge-bleu »).
+// Un titre uniquement numérique est préfixé par « game- » pour ne jamais être
+// confondu avec un identifiant numérique ; un titre sans lettre ni chiffre
+// donne « game ».
+func Make(title string) string {
+	folded, _, err := transform.String(transform.Chain(norm.NFKD, runes.Remove(runes.In(unicode.Mn))), title)
+	if err != nil {
+		folded = title
+	}
+
+	var b strings.Builder
+	pendingDash := false
+	for _, r := range strings.ToLower(folded) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if pendingDash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			pendingDash = false
+			b.WriteRune(r)
+			continue
+		}
+		pendingDash = true
+	}
+
+	result := b.String()
+	if len(result) > baseLength {
+		result = result[:baseLength]
+		if i := strings.LastIndexByte(result, '-'); i > 0 {
+			result = result[:i]
+		}
+	}
+
+	switch {
+	case result == "":
+		return "game"
+	case strings.Trim(result, "0123456789") == "":
+		return "game-" + result
+	}
+	return result
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"strings"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	"gorm.io/gorm"
+)
+
+// applyAgeRatingFilters applique les filtres max_age et rating_board. Avec un
+// organisme, le jeu doit être classé par cet organisme (et à un âge minimum
+// inférieur ou égal à max_age). Sans organisme, le jeu doit être classé et
+// aucune de ses classifications ne doit dépasser max_age. Les jeux non classés
+// sont donc toujours exclus par ces filtres.
+func applyAgeRatingFilters(query *gorm.DB, filter *models.GameFilter) *gorm.DB {
+	const exists = "EXISTS (SELECT 1 FROM age_ratings WHERE age_ratings.game_id = games.id"
+
+	if filter.RatingBoard != "" {
+		if filter.MaxAge != nil {
+			return query.Where(exists+" AND age_ratings.board = ? AND age_ratings.minimum_age <= ?)",
+				strings.ToUpper(filter.RatingBoard), *filter.MaxAge)
+		}
+		return query.Where(exists+" AND age_ratings.board = ?)", strings.ToUpper(filter.RatingBoard))
+	}
+
+	if filter.MaxAge != nil {
+		return query.Where(exists+")").
+			Where("NOT "+exists+" AND age_ratings.minimum_age > ?)", *filter.MaxAge)
+	}
+
+	return query
+}
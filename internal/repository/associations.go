@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// gameAssociation décrit une relation many-to-many entre les jeux et une
+// table de référence (genres, plateformes) identifiée par id et par nom.
+// scope, s'il est renseigné, restreint les entrées prises en compte par les
+// filtres et les facettes (par exemple aux seuls tags approuvés).
+type gameAssociation struct {
+	joinTable  string
+	foreignKey string
+	table      string
+	scope      string
+}
+
+var (
+	genreAssociation     = gameAssociation{joinTable: "game_genres", foreignKey: "genre_id", table: "genres"}
+	platformAssociation  = gameAssociation{joinTable: "game_platforms", foreignKey: "platform_id", table: "platforms"}
+	developerAssociation = gameAssociation{joinTable: "game_developers", foreignKey: "company_id", table: "companies"}
+	publisherAssociation = gameAssociation{joinTable: "game_publishers", foreignKey: "company_id", table: "companies"}
+	tagAssociation       = gameAssociation{joinTable: "game_tags", foreignKey: "tag_id", table: "tags", scope: "tags.status = 'approved'"}
+)
+
+// exists retourne une condition vraie lorsque le jeu courant est lié à au
+// moins une entrée de la table vérifiant la condition donnée.
+func (a gameAssociation) exists(condition string) string {
+	if a.scope != "" {
+		condition = a.scope + " AND " + condition
+	}
+	return fmt.Sprintf(
+		"EXISTS (SELECT 1 FROM %[1]s JOIN %[3]s ON %[3]s.id = %[1]s.%[2]s WHERE %[1]s.game_id = games.id AND %[4]s)",
+		a.joinTable, a.foreignKey, a.table, condition,
+	)
+}
+
+// filter restreint la requête aux jeux liés à l'une (matchAll=false) ou à
+// toutes (matchAll=true) les entrées désignées par nom ou par id. Les
+// sous-requêtes EXISTS évitent les jointures qui dupliqueraient les jeux et
+// fausseraient le comptage.
+func (a gameAssociation) filter(query *gorm.DB, names []string, ids []uint, matchAll bool) *gorm.DB {
+	if len(names) == 0 && len(ids) == 0 {
+		return query
+	}
+
+	if matchAll {
+		for _, name := range names {
+			query = query.Where(a.exists(a.table+".name = ?"), name)
+		}
+		for _, id := range ids {
+			query = query.Where(a.exists(a.table+".id = ?"), id)
+		}
+		return query
+	}
+
+	var conditions []string
+	var vars []interface{}
+	if len(names) > 0 {
+		conditions = append(conditions, a.table+".name IN ?")
+		vars = append(vars, names)
+	}
+	if len(ids) > 0 {
+		conditions = append(conditions, a.table+".id IN ?")
+		vars = append(vars, ids)
+	}
+
+	return query.Where(a.exists("("+strings.Join(conditions, " OR ")+")"), vars...)
+}
+
+// exclude écarte les jeux liés à l'une des entrées désignées par nom ou par
+// id ; les ids sont comparés directement dans la table de liaison.
+func (a gameAssociation) exclude(query *gorm.DB, names []string, ids []uint) *gorm.DB {
+	if len(names) > 0 {
+		query = query.Where("NOT "+a.exists(a.table+".name IN ?"), names)
+	}
+	if len(ids) > 0 {
+		query = query.Where(fmt.Sprintf(
+			"NOT EXISTS (SELECT 1 FROM %[1]s WHERE %[1]s.game_id = games.id AND %[1]s.%[2]s IN ?)",
+			a.joinTable, a.foreignKey,
+		), ids)
+	}
+
+	return query
+}
+
+// countGames retourne le nombre de jeux liés à l'entrée donnée.
+func (a gameAssociation) countGames(tx *gorm.DB, id uint) (int64, error) {
+	var count int64
+	err := tx.Table(a.joinTable).Where(a.foreignKey+" = ?", id).Count(&count).Error
+	return count, err
+}
+
+// unlink supprime tous les liens entre les jeux et l'entrée donnée.
+func (a gameAssociation) unlink(tx *gorm.DB, id uint) error {
+	return tx.Exec("DELETE FROM "+a.joinTable+" WHERE "+a.foreignKey+" = ?", id).Error
+}
+
+// relink reporte les liens de l'entrée source sur l'entrée cible, sans créer
+// de doublon pour les jeux déjà liés aux deux.
+func (a gameAssociation) relink(tx *gorm.DB, sourceID, targetID uint) error {
+	err := tx.Exec(
+		fmt.Sprintf("INSERT INTO %[1]s (game_id, %[2]s) SELECT game_id, ? FROM %[1]s WHERE %[2]s = ? ON CONFLICT DO NOTHING", a.joinTable, a.foreignKey),
+		targetID, sourceID,
+	).Error
+	if err != nil {
+		return err
+	}
+
+	return a.unlink(tx, sourceID)
+}
+
+// link associe l'entrée donnée au jeu, sans erreur si le lien existe déjà.
+func (a gameAssociation) link(tx *gorm.DB, gameID, id uint) error {
+	return tx.Exec(
+		fmt.Sprintf("INSERT INTO %s (game_id, %s) VALUES (?, ?) ON CONFLICT DO NOTHING", a.joinTable, a.foreignKey),
+		gameID, id,
+	).Error
+}
+
+// unlinkGame dissocie l'entrée donnée du jeu et retourne le nombre de liens
+// supprimés.
+func (a gameAssociation) unlinkGame(tx *gorm.DB, gameID, id uint) (int64, error) {
+	result := tx.Exec("DELETE FROM "+a.joinTable+" WHERE game_id = ? AND "+a.foreignKey+" = ?", gameID, id)
+	return result.RowsAffected, result.Error
+}
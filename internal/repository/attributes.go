@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"encoding/json"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	"gorm.io/gorm"
+)
+
+// applyAttributeFilters applique les filtres de langues, de modes de jeu, de
+// nombre de joueurs et d'accessibilité. Chaque valeur demandée est exigée.
+func applyAttributeFilters(query *gorm.DB, filter *models.GameFilter) *gorm.DB {
+	query = languageFilter(query, "interface", filter.InterfaceLanguages)
+	query = languageFilter(query, "audio", filter.AudioLanguages)
+	query = languageFilter(query, "subtitles", filter.SubtitleLanguages)
+
+	if len(filter.GameModes) > 0 {
+		query = query.Where("games.game_modes @> CAST(? AS jsonb)", jsonArray(filter.GameModes))
+	}
+	if len(filter.Accessibility) > 0 {
+		query = query.Where("games.accessibility @> CAST(? AS jsonb)", jsonArray(filter.Accessibility))
+	}
+	if filter.Players != nil {
+		query = query.Where("games.min_players <= ? AND games.max_players >= ?", *filter.Players, *filter.Players)
+	}
+
+	return query
+}
+
+// languageFilter exige, pour chaque langue, sa prise en charge dans le mode
+// donné (colonne interface, audio ou subtitles de game_languages). Une langue
+// de base (« fr ») couvre aussi ses variantes régionales (« fr-CA »).
+func languageFilter(query *gorm.DB, column string, languages []string) *gorm.DB {
+	for _, language := range languages {
+		query = query.Where(
+			"EXISTS (SELECT 1 FROM game_languages WHERE game_languages.game_id = games.id AND game_languages."+column+
+				" AND (game_languages.language = ? OR split_part(game_languages.language, '-', 1) = ?))",
+			language, language,
+		)
+	}
+	return query
+}
+
+func jsonArray(values []string) string {
+	encoded, _ := json.Marshal(values)
+	return string(encoded)
+}
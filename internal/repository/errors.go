@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"errors"
+
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// translateError convertit les erreurs GORM/PostgreSQL en erreurs métier typées.
+func translateError(err error, entity string) error {
+	if err == nil {
+		return nil
+	}
+
+	var appErr *apperrors.Error
+	switch {
+	case errors.As(err, &appErr):
+		return err
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return apperrors.NotFound("%s not found", entity)
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return apperrors.Wrap(apperrors.CodeConflict, err, "%s already exists", entity)
+	case errors.Is(err, gorm.ErrForeignKeyViolated):
+		return apperrors.Wrap(apperrors.CodeConflict, err, "%s references a missing or still-used resource", entity)
+	default:
+		return apperrors.Internal(err)
+	}
+}
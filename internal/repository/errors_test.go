@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestTranslateError(t *testing.T) {
+	assert.NoError(t, translateError(nil, "game"))
+
+	tests := []struct {
+		name    string
+		err     error
+		code    apperrors.Code
+		message string
+		wraps   bool
+	}{
+		{"enregistrement introuvable", gorm.ErrRecordNotFound, apperrors.CodeNotFound, "game not found", false},
+		{"introuvable enveloppé", fmt.Errorf("first: %w", gorm.ErrRecordNotFound), apperrors.CodeNotFound, "game not found", false},
+		{"clé dupliquée", gorm.ErrDuplicatedKey, apperrors.CodeConflict, "game already exists", true},
+		{"clé étrangère", gorm.ErrForeignKeyViolated, apperrors.CodeConflict, "game references a missing or still-used resource", true},
+		{"erreur inattendue", errors.New("pq: connection refused to 10.0.0.3"), apperrors.CodeInternal, apperrors.ErrInternal.Message, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := translateError(tt.err, "game")
+
+			assert.Equal(t, tt.code, apperrors.CodeOf(err))
+			assert.Equal(t, tt.message, apperrors.MessageOf(err))
+			if tt.wraps {
+				assert.ErrorIs(t, err, tt.err, "l'erreur d'origine reste accessible pour les journaux")
+			}
+		})
+	}
+
+	t.Run("erreur métier conservée", func(t *testing.T) {
+		original := apperrors.InvalidFields("invalid", apperrors.FieldError{Field: "title", Message: "required"})
+
+		assert.Same(t, original, translateError(original, "game"))
+	})
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	"gorm.io/gorm"
+)
+
+// GetGameByExternalID charge le jeu associé à l'identifiant externe donné.
+func (r *PostgresGameRepository) GetGameByExternalID(ctx context.Context, provider, externalID string) (*models.Game, error) {
+	var mapping models.GameExternalID
+	err := r.db.WithContext(ctx).Where("provider = ? AND external_id = ?", provider, externalID).First(&mapping).Error
+	if err != nil {
+		return nil, translateError(err, "game")
+	}
+
+	return r.GetByID(ctx, mapping.GameID)
+}
+
+// FindExternalIDs renvoie, parmi les identifiants donnés, ceux déjà associés
+// à un jeu (supprimé ou non).
+func (r *PostgresGameRepository) FindExternalIDs(ctx context.Context, ids []models.GameExternalID) ([]models.GameExternalID, error) {
+	var mappings []models.GameExternalID
+	if len(ids) == 0 {
+		return mappings, nil
+	}
+
+	pairs := make([][]interface{}, 0, len(ids))
+	for _, id := range ids {
+		pairs = append(pairs, []interface{}{id.Provider, id.ExternalID})
+	}
+
+	err := r.db.WithContext(ctx).Where("(provider, external_id) IN ?", pairs).Find(&mappings).Error
+	return mappings, translateError(err, "external id")
+}
+
+// applyExternalIDFilter restreint la liste aux jeux sans identifiant chez le
+// fournisseur demandé, pour repérer les fiches à rapprocher.
+func applyExternalIDFilter(query *gorm.DB, filter *models.GameFilter) *gorm.DB {
+	if filter.MissingExternalID == "" {
+		return query
+	}
+
+	return query.Where(
+		"NOT EXISTS (SELECT 1 FROM game_external_ids WHERE game_external_ids.game_id = games.id AND game_external_ids.provider = ?)",
+		filter.MissingExternalID,
+	)
+}
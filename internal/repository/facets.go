@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	"gorm.io/gorm"
+)
+
+// facetLimit borne le nombre de valeurs retournées pour les facettes à forte
+// cardinalité (sociétés de développement et d'édition).
+const facetLimit = 20
+
+// ratingBucketExpr range la note moyenne dans une tranche d'un point, la note
+// maximale (5) rejoignant la tranche 4-5.
+const ratingBucketExpr = "LEAST(FLOOR(games.average_rating), 4)::int"
+
+// facets calcule les compteurs de chaque facette. Le critère de la facette
+// elle-même est ignoré, afin que chaque valeur indique le nombre de résultats
+// obtenus si on la sélectionnait, les autres critères restant inchangés.
+func (r *PostgresGameRepository) facets(ctx context.Context, filter *models.GameFilter) (*models.GameFacets, error) {
+	facets := &models.GameFacets{}
+
+	withoutGenres := *filter
+	withoutGenres.Genres = nil
+	withoutGenres.GenreIDs = nil
+	err := r.associationFacet(ctx, &withoutGenres, genreAssociation).Scan(&facets.Genres).Error
+	if err != nil {
+		return nil, translateError(err, "genre")
+	}
+
+	withoutPlatforms := *filter
+	withoutPlatforms.Platforms = nil
+	withoutPlatforms.PlatformIDs = nil
+	err = r.associationFacet(ctx, &withoutPlatforms, platformAssociation).Scan(&facets.Platforms).Error
+	if err != nil {
+		return nil, translateError(err, "platform")
+	}
+
+	withoutDeveloper := *filter
+	withoutDeveloper.Developer = ""
+	withoutDeveloper.DeveloperIDs = nil
+	err = r.associationFacet(ctx, &withoutDeveloper, developerAssociation).Limit(facetLimit).Scan(&facets.Developers).Error
+	if err != nil {
+		return nil, translateError(err, "company")
+	}
+
+	withoutPublisher := *filter
+	withoutPublisher.Publisher = ""
+	withoutPublisher.PublisherIDs = nil
+	err = r.associationFacet(ctx, &withoutPublisher, publisherAssociation).Limit(facetLimit).Scan(&facets.Publishers).Error
+	if err != nil {
+		return nil, translateError(err, "company")
+	}
+
+	withoutTags := *filter
+	withoutTags.Tags = nil
+	err = r.associationFacet(ctx, &withoutTags, tagAssociation).Limit(facetLimit).Scan(&facets.Tags).Error
+	if err != nil {
+		return nil, translateError(err, "tag")
+	}
+
+	withoutRating := *filter
+	withoutRating.MinRating = nil
+	facets.Ratings, err = r.ratingFacet(ctx, &withoutRating)
+	if err != nil {
+		return nil, translateError(err, "game")
+	}
+
+	return facets, nil
+}
+
+// matchingGameIDs retourne la sous-requête des identifiants de jeux
+// correspondant au filtre.
+func (r *PostgresGameRepository) matchingGameIDs(ctx context.Context, filter *models.GameFilter) *gorm.DB {
+	query := r.db.WithContext(ctx).Model(&models.Game{})
+	if filter.IncludeDeleted {
+		query = query.Unscoped()
+	}
+
+	return r.applyFilters(query, filter).Select("games.id")
+}
+
+func (r *PostgresGameRepository) associationFacet(ctx context.Context, filter *models.GameFilter, association gameAssociation) *gorm.DB {
+	query := r.db.WithContext(ctx)
+	if association.scope != "" {
+		query = query.Where(association.scope)
+	}
+
+	return query.Table(association.joinTable).
+		Select(fmt.Sprintf("%[1]s.id AS id, %[1]s.name AS value, COUNT(*) AS count", association.table)).
+		Joins(fmt.Sprintf("JOIN %[1]s ON %[1]s.id = %[2]s.%[3]s", association.table, association.joinTable, association.foreignKey)).
+		Where(association.joinTable+".game_id IN (?)", r.matchingGameIDs(ctx, filter)).
+		Group(association.table + ".id, " + association.table + ".name").
+		Order("count DESC, value ASC")
+}
+
+func (r *PostgresGameRepository) ratingFacet(ctx context.Context, filter *models.GameFilter) ([]models.FacetCount, error) {
+	var buckets []struct {
+		Bucket int
+		Count  int64
+	}
+	err := r.db.WithContext(ctx).Table("games").
+		Select(ratingBucketExpr+" AS bucket, COUNT(*) AS count").
+		Where("games.id IN (?)", r.matchingGameIDs(ctx, filter)).
+		Where("games.average_rating IS NOT NULL").
+		Group("bucket").
+		Order("bucket DESC").
+		Scan(&buckets).Error
+	if err != nil {
+		return nil, err
+	}
+
+	ratings := make([]models.FacetCount, 0, len(buckets))
+	for _, bucket := range buckets {
+		ratings = append(ratings, models.FacetCount{
+			Value: fmt.Sprintf("%d-%d", bucket.Bucket, bucket.Bucket+1),
+			Count: bucket.Count,
+		})
+	}
+
+	return ratings, nil
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Conditions produites par chaque critère du filtre de facetFilter.
+var facetConditions = map[string]string{
+	"genres":     "genres.name IN ('RPG')",
+	"platforms":  "platforms.name IN ('PC')",
+	"developers": "game_developers.game_id = games.id",
+	"publishers": "game_publishers.game_id = games.id",
+	"tags":       "tags.name IN ('roguelike')",
+	"ratings":    "average_rating >= 3",
+	"currency":   "games.currency = 'EUR'",
+}
+
+func facetFilter() *models.GameFilter {
+	rating := 3.0
+	return &models.GameFilter{
+		Genres:       []string{"RPG"},
+		Platforms:    []string{"PC"},
+		DeveloperIDs: []uint{4},
+		PublisherIDs: []uint{5},
+		Tags:         []string{"roguelike"},
+		MinRating:    &rating,
+		Currency:     "EUR",
+		Facets:       true,
+	}
+}
+
+// facetSubqueries retourne, pour chaque facette, la sous-requête qui
+// sélectionne les jeux comptés.
+func facetSubqueries(t *testing.T, statements []string) map[string]string {
+	t.Helper()
+	prefixes := map[string]string{
+		"genres":     "SELECT genres.id AS id",
+		"platforms":  "SELECT platforms.id AS id",
+		"developers": `SELECT companies.id AS id, companies.name AS value, COUNT(*) AS count FROM "game_developers"`,
+		"publishers": `SELECT companies.id AS id, companies.name AS value, COUNT(*) AS count FROM "game_publishers"`,
+		"tags":       "SELECT tags.id AS id",
+		"ratings":    "SELECT LEAST(",
+	}
+
+	subqueries := make(map[string]string, len(prefixes))
+	for _, statement := range statements {
+		for facet, prefix := range prefixes {
+			if strings.HasPrefix(statement, prefix) {
+				start := strings.Index(statement, "(SELECT games.id")
+				require.NotEqual(t, -1, start, statement)
+				subqueries[facet] = statement[start:]
+			}
+		}
+	}
+	require.Len(t, subqueries, len(prefixes))
+	return subqueries
+}
+
+func TestFacetsIgnoreOnlyTheirOwnCriterion(t *testing.T) {
+	r, recorder := newTestRepository(t)
+
+	_, err := r.facets(t.Context(), facetFilter())
+	require.NoError(t, err)
+
+	for facet, subquery := range facetSubqueries(t, recorder.statements) {
+		for criterion, condition := range facetConditions {
+			if criterion == facet {
+				assert.NotContains(t, subquery, condition, "la facette %s doit ignorer son propre critère", facet)
+			} else {
+				assert.Contains(t, subquery, condition, "la facette %s doit appliquer le critère %s", facet, criterion)
+			}
+		}
+	}
+}
+
+func TestFacetsRespectSoftDelete(t *testing.T) {
+	t.Run("jeux supprimés exclus par défaut", func(t *testing.T) {
+		r, recorder := newTestRepository(t)
+
+		_, err := r.facets(t.Context(), facetFilter())
+		require.NoError(t, err)
+
+		for facet, subquery := range facetSubqueries(t, recorder.statements) {
+			assert.Contains(t, subquery, `"games"."deleted_at" IS NULL`, facet)
+		}
+	})
+
+	t.Run("jeux supprimés inclus sur demande", func(t *testing.T) {
+		r, recorder := newTestRepository(t)
+		filter := facetFilter()
+		filter.IncludeDeleted = true
+
+		_, err := r.facets(t.Context(), filter)
+		require.NoError(t, err)
+
+		for facet, subquery := range facetSubqueries(t, recorder.statements) {
+			assert.NotContains(t, subquery, "deleted_at", facet)
+		}
+	})
+}
+
+func TestFacetsKeepExclusions(t *testing.T) {
+	r, recorder := newTestRepository(t)
+	filter := facetFilter()
+	filter.ExcludeGenreIDs = []uint{9}
+
+	_, err := r.facets(t.Context(), filter)
+	require.NoError(t, err)
+
+	for facet, subquery := range facetSubqueries(t, recorder.statements) {
+		assert.Contains(t, subquery, "NOT EXISTS (SELECT 1 FROM game_genres WHERE game_genres.game_id = games.id AND game_genres.genre_id IN (9))", facet)
+	}
+}
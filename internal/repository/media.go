@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	"gorm.io/gorm"
+)
+
+// FindGameMedia charge en une requête les galeries des jeux donnés, dans
+// l'ordre d'affichage.
+func (r *PostgresGameRepository) FindGameMedia(ctx context.Context, gameIDs []uint) ([]models.GameMedia, error) {
+	var media []models.GameMedia
+	if len(gameIDs) == 0 {
+		return media, nil
+	}
+
+	err := r.db.WithContext(ctx).Where("game_id IN ?", gameIDs).Order("game_id, position, id").Find(&media).Error
+	return media, translateError(err, "media")
+}
+
+// AddGameMedia ajoute le média en fin de galerie. Il devient le média
+// principal de son type s'il est demandé comme tel ou s'il est le premier de
+// son type.
+func (r *PostgresGameRepository) AddGameMedia(ctx context.Context, media *models.GameMedia) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.GameMedia{}).
+			Where("game_id = ?", media.GameID).
+			Select("COALESCE(MAX(position) + 1, 0)").
+			Scan(&media.Position).Error
+		if err != nil {
+			return err
+		}
+
+		if media.Primary {
+			err = unsetPrimaryMedia(tx, media.GameID, media.Type)
+			if err != nil {
+				return err
+			}
+		} else {
+			var count int64
+			err = tx.Model(&models.GameMedia{}).
+				Where("game_id = ? AND type = ? AND is_primary", media.GameID, media.Type).
+				Count(&count).Error
+			if err != nil {
+				return err
+			}
+			media.Primary = count == 0
+		}
+
+		return tx.Omit("Game").Create(media).Error
+	})
+	return translateError(err, "media")
+}
+
+// ReorderGameMedia donne à chaque média la position de son identifiant dans
+// mediaIDs.
+func (r *PostgresGameRepository) ReorderGameMedia(ctx context.Context, gameID uint, mediaIDs []uint) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for position, id := range mediaIDs {
+			err := tx.Model(&models.GameMedia{}).
+				Where("id = ? AND game_id = ?", id, gameID).
+				Update("position", position).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return translateError(err, "media")
+}
+
+// DeleteGameMedia retire un média de la galerie ; s'il était principal, le
+// suivant du même type dans l'ordre d'affichage le remplace.
+func (r *PostgresGameRepository) DeleteGameMedia(ctx context.Context, gameID, mediaID uint) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var media models.GameMedia
+		err := tx.Where("game_id = ?", gameID).First(&media, mediaID).Error
+		if err != nil {
+			return translateError(err, "media")
+		}
+
+		err = tx.Delete(&media).Error
+		if err != nil {
+			return err
+		}
+
+		if !media.Primary {
+			return nil
+		}
+
+		var next models.GameMedia
+		err = tx.Where("game_id = ? AND type = ?", gameID, media.Type).Order("position, id").Limit(1).Find(&next).Error
+		if err != nil || next.ID == 0 {
+			return err
+		}
+		return tx.Model(&next).Update("is_primary", true).Error
+	})
+	return translateError(err, "media")
+}
+
+func unsetPrimaryMedia(tx *gorm.DB, gameID uint, mediaType string) error {
+	return tx.Model(&models.GameMedia{}).
+		Where("game_id = ? AND type = ? AND is_primary", gameID, mediaType).
+		Update("is_primary", false).Error
+}
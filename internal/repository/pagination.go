@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// gameSort décrit le tri appliqué à une liste de jeux : une expression SQL,
+// départagée par games.id dans le même sens pour garantir un ordre total.
+type gameSort struct {
+	key      string
+	expr     string
+	vars     []interface{}
+	castType string
+	desc     bool
+	value    func(game *models.Game) string
+}
+
+// gameCursor est le contenu (opaque pour le client) d'un curseur de pagination.
+type gameCursor struct {
+	Key   string `json:"k"`
+	Desc  bool   `json:"d"`
+	Value string `json:"v"`
+	ID    uint   `json:"id"`
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// zeroTime est la valeur zéro de time.Time, telle que formatTime la restitue.
+const zeroTime = "'0001-01-01T00:00:00Z'::timestamptz"
+
+// sortableColumns sont les colonnes triables. Une colonne pouvant être NULL
+// est ramenée à la valeur zéro que le modèle lit à sa place : sans cela, la
+// comparaison de tuples du curseur vaudrait NULL et ces lignes disparaîtraient
+// des pages suivantes.
+var sortableColumns = map[string]gameSort{
+	"title": {
+		expr: "games.title", castType: "text",
+		value: func(game *models.Game) string { return game.Title },
+	},
+	"release_date": {
+		expr: "COALESCE(games.release_date, " + zeroTime + ")", castType: "timestamptz",
+		value: func(game *models.Game) string { return formatTime(game.ReleaseDate) },
+	},
+	"price": {
+		expr: "games.price", castType: "numeric",
+		value: func(game *models.Game) string { return game.Price.String() },
+	},
+	"average_rating": {
+		expr: "COALESCE(games.average_rating, 0)", castType: "numeric",
+		value: func(game *models.Game) string { return formatFloat(game.AverageRating) },
+	},
+	"created_at": {
+		expr: "COALESCE(games.created_at, " + zeroTime + ")", castType: "timestamptz",
+		value: func(game *models.Game) string { return formatTime(game.CreatedAt) },
+	},
+	"updated_at": {
+		expr: "COALESCE(games.updated_at, " + zeroTime + ")", castType: "timestamptz",
+		value: func(game *models.Game) string { return formatTime(game.UpdatedAt) },
+	},
+}
+
+// resolveSort détermine le tri à partir du filtre. Sans sort_by, une recherche
+// plein texte trie par pertinence, une recherche approximative par similarité,
+// et sinon par identifiant.
+func (r *PostgresGameRepository) resolveSort(filter *models.GameFilter) gameSort {
+	sortBy := strings.ToLower(filter.SortBy)
+	desc := strings.ToUpper(filter.SortOrder) == "DESC"
+
+	if sortBy == "" {
+		switch {
+		case filter.Query != "":
+			sortBy, desc = "relevance", true
+		case filter.Fuzzy && filter.Title != "":
+			sortBy, desc = "similarity", true
+		}
+	}
+
+	var sort gameSort
+	switch {
+	case sortBy == "relevance" && filter.Query != "":
+		sort = gameSort{
+			expr: "ts_rank(games.search_vector, " + searchTsQuery + ")", castType: "real",
+			vars:  []interface{}{r.searchLanguage, filter.Query},
+			value: func(game *models.Game) string { return formatFloat(game.Relevance) },
+		}
+	case sortBy == "similarity" && filter.Fuzzy && filter.Title != "":
+		sort = gameSort{
+			expr: titleSimilarityExpr, castType: "real",
+			vars:  []interface{}{filter.Title},
+			value: func(game *models.Game) string { return formatFloat(game.TitleSimilarity) },
+		}
+	default:
+		column, ok := sortableColumns[sortBy]
+		if !ok {
+			return gameSort{key: "id", desc: desc}
+		}
+		sort = column
+	}
+
+	sort.key = sortBy
+	sort.desc = desc
+	return sort
+}
+
+func (s gameSort) direction() string {
+	if s.desc {
+		return "DESC"
+	}
+	return "ASC"
+}
+
+func (s gameSort) apply(query *gorm.DB) *gorm.DB {
+	if s.expr == "" {
+		return query.Order("games.id " + s.direction())
+	}
+
+	return query.Order(clause.OrderBy{Expression: clause.Expr{
+		SQL:  s.expr + " " + s.direction() + ", games.id " + s.direction(),
+		Vars: s.vars,
+	}})
+}
+
+// after restreint la requête aux lignes situées après le curseur, par une
+// comparaison de tuples (valeur, id) compatible avec les index.
+func (s gameSort) after(query *gorm.DB, cursor *gameCursor) *gorm.DB {
+	operator := ">"
+	if s.desc {
+		operator = "<"
+	}
+
+	if s.expr == "" {
+		return query.Where("games.id "+operator+" ?", cursor.ID)
+	}
+
+	vars := append(append([]interface{}{}, s.vars...), cursor.Value, cursor.ID)
+	return query.Where(
+		fmt.Sprintf("(%s, games.id) %s (CAST(? AS %s), ?)", s.expr, operator, s.castType),
+		vars...,
+	)
+}
+
+func (s gameSort) cursorFor(game *models.Game) string {
+	cursor := gameCursor{Key: s.key, Desc: s.desc, ID: game.ID}
+	if s.value != nil {
+		cursor.Value = s.value(game)
+	}
+
+	payload, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+func (s gameSort) decodeCursor(encoded string) (*gameCursor, error) {
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, invalidCursor()
+	}
+
+	var cursor gameCursor
+	err = json.Unmarshal(payload, &cursor)
+	if err != nil {
+		return nil, invalidCursor()
+	}
+
+	if cursor.Key != s.key || cursor.Desc != s.desc {
+		return nil, apperrors.InvalidFields("cursor does not match the requested sort", apperrors.FieldError{
+			Field:   "cursor",
+			Message: "the cursor was issued for a different sort_by or sort_order",
+		})
+	}
+
+	return &cursor, nil
+}
+
+func invalidCursor() error {
+	return apperrors.InvalidFields("invalid cursor", apperrors.FieldError{
+		Field:   "cursor",
+		Message: "malformed pagination cursor",
+	})
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	r, _ := newTestRepository(t)
+	game := &models.Game{
+		ID:            42,
+		Title:         "Hades",
+		ReleaseDate:   time.Date(2020, time.September, 17, 0, 0, 0, 0, time.UTC),
+		Price:         decimal.RequireFromString("24.99"),
+		AverageRating: 4.75,
+	}
+
+	tests := []struct {
+		name   string
+		filter models.GameFilter
+		value  string
+	}{
+		{"id par défaut", models.GameFilter{}, ""},
+		{"titre", models.GameFilter{SortBy: "title"}, "Hades"},
+		{"date de sortie décroissante", models.GameFilter{SortBy: "release_date", SortOrder: "desc"}, "2020-09-17T00:00:00Z"},
+		{"prix", models.GameFilter{SortBy: "price", SortOrder: "DESC"}, "24.99"},
+		{"note", models.GameFilter{SortBy: "average_rating"}, "4.75"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sort := r.resolveSort(&tt.filter)
+
+			cursor, err := sort.decodeCursor(sort.cursorFor(game))
+
+			require.NoError(t, err)
+			assert.Equal(t, uint(42), cursor.ID)
+			assert.Equal(t, tt.value, cursor.Value)
+			assert.Equal(t, sort.key, cursor.Key)
+			assert.Equal(t, sort.desc, cursor.Desc)
+		})
+	}
+}
+
+func TestDecodeCursorErrors(t *testing.T) {
+	r, _ := newTestRepository(t)
+	issued := r.resolveSort(&models.GameFilter{SortBy: "price", SortOrder: "desc"}).cursorFor(&models.Game{ID: 1})
+
+	tests := []struct {
+		name   string
+		filter models.GameFilter
+		cursor string
+	}{
+		{"base64 invalide", models.GameFilter{}, "%%%not-base64"},
+		{"JSON invalide", models.GameFilter{}, base64.RawURLEncoding.EncodeToString([]byte("{not json"))},
+		{"autre sort_by", models.GameFilter{SortBy: "title", SortOrder: "desc"}, issued},
+		{"autre sort_order", models.GameFilter{SortBy: "price", SortOrder: "asc"}, issued},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := r.resolveSort(&tt.filter).decodeCursor(tt.cursor)
+
+			assert.ErrorIs(t, err, apperrors.ErrValidation)
+			require.Len(t, apperrors.FieldsOf(err), 1)
+			assert.Equal(t, "cursor", apperrors.FieldsOf(err)[0].Field)
+		})
+	}
+}
+
+func TestListInvalidCursorIsValidationError(t *testing.T) {
+	r, _ := newTestRepository(t)
+
+	_, err := r.List(t.Context(), &models.GameFilter{Cursor: "!!!"})
+
+	assert.ErrorIs(t, err, apperrors.ErrValidation)
+	assert.NotErrorIs(t, err, apperrors.ErrInternal)
+}
+
+func TestKeysetOrderAndTieBreak(t *testing.T) {
+	r, _ := newTestRepository(t)
+
+	tests := []struct {
+		name   string
+		filter models.GameFilter
+		where  string
+		order  string
+	}{
+		{
+			"id croissant", models.GameFilter{},
+			"games.id > 7", "ORDER BY games.id ASC",
+		},
+		{
+			"id décroissant", models.GameFilter{SortOrder: "desc"},
+			"games.id < 7", "ORDER BY games.id DESC",
+		},
+		{
+			"prix croissant départagé par id", models.GameFilter{SortBy: "price"},
+			"(games.price, games.id) > (CAST('9.99' AS numeric), 7)", "ORDER BY games.price ASC, games.id ASC",
+		},
+		{
+			"date décroissante départagée par id", models.GameFilter{SortBy: "release_date", SortOrder: "desc"},
+			"(COALESCE(games.release_date, '0001-01-01T00:00:00Z'::timestamptz), games.id) < (CAST('2020-01-02T00:00:00Z' AS timestamptz), 7)",
+			"ORDER BY COALESCE(games.release_date, '0001-01-01T00:00:00Z'::timestamptz) DESC, games.id DESC",
+		},
+		{
+			"note NULL ramenée à zéro", models.GameFilter{SortBy: "average_rating"},
+			"(COALESCE(games.average_rating, 0), games.id) > (CAST('0' AS numeric), 7)", "ORDER BY COALESCE(games.average_rating, 0) ASC, games.id ASC",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sort := r.resolveSort(&tt.filter)
+			cursor, err := sort.decodeCursor(sort.cursorFor(&models.Game{
+				ID:          7,
+				Price:       decimal.RequireFromString("9.99"),
+				ReleaseDate: time.Date(2020, time.January, 2, 0, 0, 0, 0, time.UTC),
+			}))
+			require.NoError(t, err)
+
+			sql := r.db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				var games []models.Game
+				return sort.apply(sort.after(tx.Model(&models.Game{}), cursor)).Find(&games)
+			})
+
+			assert.Contains(t, sql, tt.where)
+			assert.True(t, strings.HasSuffix(sql, tt.order), sql)
+		})
+	}
+}
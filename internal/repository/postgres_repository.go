@@ -2,51 +2,270 @@ package repository
 
 import (
 	"context"
-	"errors"
 	"math"
 	"strings"
 
 	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
 	"gorm.io/gorm"
 )
 
 type PostgresGameRepository struct {
-	db *gorm.DB
+	db             *gorm.DB
+	searchLanguage string
 }
 
-func NewPostgresGameRepository(db *gorm.DB) GameRepository {
+func NewPostgresGameRepository(db *gorm.DB, searchLanguage string) GameRepository {
 	return &PostgresGameRepository{
-		db: db,
+		db:             db,
+		searchLanguage: searchLanguage,
 	}
 }
 
 func (r *PostgresGameRepository) Create(ctx context.Context, game *models.Game) error {
-	return r.db.WithContext(ctx).Create(game).Error
+	return translateError(r.db.WithContext(ctx).Omit("Genres.*", "Platforms.*", "Developers.*", "Publishers.*", "Franchise", "Tags").Create(game).Error, "game")
 }
 
 func (r *PostgresGameRepository) GetByID(ctx context.Context, id uint) (*models.Game, error) {
+	return r.findGame(ctx, "games.id = ?", id)
+}
+
+// findGame charge, avec ses associations, le jeu répondant à la condition.
+func (r *PostgresGameRepository) findGame(ctx context.Context, query string, args ...interface{}) (*models.Game, error) {
 	var game models.Game
-	result := r.db.WithContext(ctx).Preload("Genres").Preload("Platforms").First(&game, id)
+	result := r.db.WithContext(ctx).Preload("Genres").Preload("Platforms").Preload("Developers").Preload("Publishers").Preload("Franchise").Preload("Prices").Preload("Releases").Preload("AgeRatings").Preload("SystemRequirements").Preload("Languages").Preload("ExternalIDs").Preload("Tags", approvedTags).Preload("Tags.Tag").Where(query, args...).First(&game)
 	if result.Error != nil {
-		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			return nil, errors.New("game not found")
-		}
-		return nil, result.Error
+		return nil, translateError(result.Error, "game")
 	}
 	return &game, nil
 }
 
 func (r *PostgresGameRepository) Update(ctx context.Context, game *models.Game) error {
-	return r.db.WithContext(ctx).Save(game).Error
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := archiveSlug(tx, game)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Omit("Genres", "Platforms", "Developers", "Publishers", "Franchise", "Prices", "Releases", "AgeRatings", "SystemRequirements", "Languages", "ExternalIDs", "Tags", "UUID").Save(game).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(game).Association("Genres").Replace(game.Genres)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(game).Association("Platforms").Replace(game.Platforms)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(game).Association("Developers").Replace(game.Developers)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(game).Association("Publishers").Replace(game.Publishers)
+		if err != nil {
+			return err
+		}
+
+		err = replacePrices(tx, game)
+		if err != nil {
+			return err
+		}
+
+		err = replaceReleases(tx, game)
+		if err != nil {
+			return err
+		}
+
+		err = replaceAgeRatings(tx, game)
+		if err != nil {
+			return err
+		}
+
+		err = replaceSystemRequirements(tx, game)
+		if err != nil {
+			return err
+		}
+
+		err = replaceLanguages(tx, game)
+		if err != nil {
+			return err
+		}
+
+		return replaceExternalIDs(tx, game)
+	})
+	return translateError(err, "game")
+}
+
+func replacePrices(tx *gorm.DB, game *models.Game) error {
+	err := tx.Where("game_id = ?", game.ID).Delete(&models.GamePrice{}).Error
+	if err != nil {
+		return err
+	}
+
+	if len(game.Prices) == 0 {
+		return nil
+	}
+
+	for i := range game.Prices {
+		game.Prices[i].ID = 0
+		game.Prices[i].GameID = game.ID
+	}
+
+	return tx.Create(&game.Prices).Error
+}
+
+func replaceReleases(tx *gorm.DB, game *models.Game) error {
+	err := tx.Where("game_id = ?", game.ID).Delete(&models.GameRelease{}).Error
+	if err != nil {
+		return err
+	}
+
+	if len(game.Releases) == 0 {
+		return nil
+	}
+
+	for i := range game.Releases {
+		game.Releases[i].ID = 0
+		game.Releases[i].GameID = game.ID
+	}
+
+	return tx.Create(&game.Releases).Error
+}
+
+func replaceAgeRatings(tx *gorm.DB, game *models.Game) error {
+	err := tx.Where("game_id = ?", game.ID).Delete(&models.AgeRating{}).Error
+	if err != nil {
+		return err
+	}
+
+	if len(game.AgeRatings) == 0 {
+		return nil
+	}
+
+	for i := range game.AgeRatings {
+		game.AgeRatings[i].ID = 0
+		game.AgeRatings[i].GameID = game.ID
+	}
+
+	return tx.Create(&game.AgeRatings).Error
+}
+
+func replaceSystemRequirements(tx *gorm.DB, game *models.Game) error {
+	err := tx.Where("game_id = ?", game.ID).Delete(&models.SystemRequirements{}).Error
+	if err != nil {
+		return err
+	}
+
+	if len(game.SystemRequirements) == 0 {
+		return nil
+	}
+
+	for i := range game.SystemRequirements {
+		game.SystemRequirements[i].ID = 0
+		game.SystemRequirements[i].GameID = game.ID
+	}
+
+	return tx.Create(&game.SystemRequirements).Error
+}
+
+func replaceLanguages(tx *gorm.DB, game *models.Game) error {
+	err := tx.Where("game_id = ?", game.ID).Delete(&models.GameLanguage{}).Error
+	if err != nil {
+		return err
+	}
+
+	if len(game.Languages) == 0 {
+		return nil
+	}
+
+	for i := range game.Languages {
+		game.Languages[i].ID = 0
+		game.Languages[i].GameID = game.ID
+	}
+
+	return tx.Create(&game.Languages).Error
+}
+
+func replaceExternalIDs(tx *gorm.DB, game *models.Game) error {
+	err := tx.Where("game_id = ?", game.ID).Delete(&models.GameExternalID{}).Error
+	if err != nil {
+		return err
+	}
+
+	if len(game.ExternalIDs) == 0 {
+		return nil
+	}
+
+	for i := range game.ExternalIDs {
+		game.ExternalIDs[i].ID = 0
+		game.ExternalIDs[i].GameID = game.ID
+	}
+
+	return tx.Create(&game.ExternalIDs).Error
 }
 
 func (r *PostgresGameRepository) Delete(ctx context.Context, id uint) error {
-	return r.db.WithContext(ctx).Delete(&models.Game{}, id).Error
+	result := r.db.WithContext(ctx).Delete(&models.Game{}, id)
+	if result.Error != nil {
+		return translateError(result.Error, "game")
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("game not found")
+	}
+	return nil
+}
+
+func (r *PostgresGameRepository) Restore(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Unscoped().Model(&models.Game{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		return translateError(result.Error, "game")
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("deleted game not found")
+	}
+	return nil
+}
+
+func (r *PostgresGameRepository) AddGenreToGame(ctx context.Context, gameID, genreID uint) error {
+	return translateError(genreAssociation.link(r.db.WithContext(ctx), gameID, genreID), "genre")
+}
+
+func (r *PostgresGameRepository) RemoveGenreFromGame(ctx context.Context, gameID, genreID uint) error {
+	removed, err := genreAssociation.unlinkGame(r.db.WithContext(ctx), gameID, genreID)
+	if err != nil {
+		return translateError(err, "genre")
+	}
+	if removed == 0 {
+		return apperrors.NotFound("genre %d is not associated with game %d", genreID, gameID)
+	}
+	return nil
+}
+
+func (r *PostgresGameRepository) AddPlatformToGame(ctx context.Context, gameID, platformID uint) error {
+	return translateError(platformAssociation.link(r.db.WithContext(ctx), gameID, platformID), "platform")
+}
+
+func (r *PostgresGameRepository) RemovePlatformFromGame(ctx context.Context, gameID, platformID uint) error {
+	removed, err := platformAssociation.unlinkGame(r.db.WithContext(ctx), gameID, platformID)
+	if err != nil {
+		return translateError(err, "platform")
+	}
+	if removed == 0 {
+		return apperrors.NotFound("platform %d is not associated with game %d", platformID, gameID)
+	}
+	return nil
 }
 
 func (r *PostgresGameRepository) List(ctx context.Context, filter *models.GameFilter) (*models.GameResponse, error) {
 	var games []models.Game
-	var totalCount int64
 	
 	if filter.Page <= 0 {
 		filter.Page = 1
@@ -56,95 +275,438 @@ func (r *PostgresGameRepository) List(ctx context.Context, filter *models.GameFi
 		filter.PageSize = 10
 	}
 	
-	query := r.db.WithContext(ctx).Model(&models.Game{}).Preload("Genres").Preload("Platforms")
-	
-	if filter.Title != "" {
-		query = query.Where("title ILIKE ?", "%"+filter.Title+"%")
-	}
-	if filter.Developer != "" {
-		query = query.Where("developer ILIKE ?", "%"+filter.Developer+"%")
-	}
-	if filter.Publisher != "" {
-		query = query.Where("publisher ILIKE ?", "%"+filter.Publisher+"%")
-	}
-	if filter.MinRating != nil {
-		query = query.Where("average_rating >= ?", *filter.MinRating)
+	query := r.db.WithContext(ctx).Model(&models.Game{})
+	if filter.IncludeDeleted {
+		query = query.Unscoped()
 	}
+	query = r.applyFilters(query, filter)
 	
-	if len(filter.Genres) > 0 {
-		query = query.Joins("JOIN game_genres ON games.id = game_genres.game_id").
-			Joins("JOIN genres ON genres.id = game_genres.genre_id").
-			Where("genres.name IN ?", filter.Genres).
-			Group("games.id")
+	sort := r.resolveSort(filter)
+	response := &models.GameResponse{
+		PageSize: filter.PageSize,
 	}
 	
-	if len(filter.Platforms) > 0 {
-		query = query.Joins("JOIN game_platforms ON games.id = game_platforms.game_id").
-			Joins("JOIN platforms ON platforms.id = game_platforms.platform_id").
-			Where("platforms.name IN ?", filter.Platforms).
-			Group("games.id")
+	if filter.Cursor != "" {
+		cursor, err := sort.decodeCursor(filter.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		query = sort.after(query, cursor).Limit(filter.PageSize + 1)
+	} else {
+		err := query.Count(&response.TotalCount).Error
+		if err != nil {
+			return nil, translateError(err, "game")
+		}
+		
+		response.Page = filter.Page
+		response.TotalPages = int(math.Ceil(float64(response.TotalCount) / float64(filter.PageSize)))
+		query = query.Offset((filter.Page - 1) * filter.PageSize).Limit(filter.PageSize)
 	}
 	
-	err := query.Count(&totalCount).Error
+	query = r.selectColumns(query, filter).Preload("Genres").Preload("Platforms").Preload("Developers").Preload("Publishers").Preload("Prices").Preload("Releases").Preload("AgeRatings").Preload("SystemRequirements").Preload("Languages").Preload("ExternalIDs").Preload("Tags", approvedTags).Preload("Tags.Tag")
+	err := sort.apply(query).Find(&games).Error
 	if err != nil {
-		return nil, err
+		return nil, translateError(err, "game")
 	}
 	
-	if filter.SortBy != "" {
-		validFields := map[string]bool{
-			"title": true, "release_date": true, "price": true, "average_rating": true, "created_at": true,
+	hasMore := int64((filter.Page-1)*filter.PageSize+len(games)) < response.TotalCount
+	if filter.Cursor != "" {
+		hasMore = len(games) > filter.PageSize
+		if hasMore {
+			games = games[:filter.PageSize]
 		}
-		
-		sortField := "id"
-		if validFields[strings.ToLower(filter.SortBy)] {
-			sortField = strings.ToLower(filter.SortBy)
-		}
-		
-		sortOrder := "ASC"
-		if strings.ToUpper(filter.SortOrder) == "DESC" {
-			sortOrder = "DESC"
-		}
-		
-		query = query.Order(sortField + " " + sortOrder)
-	} else {
-		query = query.Order("id ASC")
+	}
+	if hasMore && len(games) > 0 {
+		response.NextCursor = sort.cursorFor(&games[len(games)-1])
 	}
 	
-	offset := (filter.Page - 1) * filter.PageSize
-	err = query.Offset(offset).Limit(filter.PageSize).Find(&games).Error
-	if err != nil {
-		return nil, err
+	if filter.Facets {
+		response.Facets, err = r.facets(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
 	}
 	
-	totalPages := int(math.Ceil(float64(totalCount) / float64(filter.PageSize)))
+	response.Games = games
+	return response, nil
+}
+
+// applyFilters applique les critères du filtre qui restreignent l'ensemble des
+// jeux retournés (hors pagination et tri).
+func (r *PostgresGameRepository) applyFilters(query *gorm.DB, filter *models.GameFilter) *gorm.DB {
+	if filter.Query != "" {
+		query = r.applySearch(query, filter.Query)
+	}
+	if filter.Title != "" && filter.Fuzzy {
+		query = applyFuzzyTitle(query, filter.Title)
+	} else if filter.Title != "" {
+		query = query.Where("title ILIKE ?", "%"+filter.Title+"%")
+	}
+	if filter.Developer != "" {
+		query = query.Where("developer ILIKE ?", "%"+filter.Developer+"%")
+	}
+	if filter.Publisher != "" {
+		query = query.Where("publisher ILIKE ?", "%"+filter.Publisher+"%")
+	}
+	if filter.MinRating != nil {
+		query = query.Where("average_rating >= ?", *filter.MinRating)
+	}
+	query = applyPriceRange(query, filter)
+	if filter.Currency != "" {
+		query = query.Where("games.currency = ?", strings.ToUpper(filter.Currency))
+	}
 	
-	response := &models.GameResponse{
-		Games:      games,
-		TotalCount: totalCount,
-		Page:       filter.Page,
-		PageSize:   filter.PageSize,
-		TotalPages: totalPages,
+	query = genreAssociation.filter(query, filter.Genres, filter.GenreIDs, filter.GenreMatch == models.MatchAll)
+	query = genreAssociation.exclude(query, filter.ExcludeGenres, filter.ExcludeGenreIDs)
+	query = platformAssociation.filter(query, filter.Platforms, filter.PlatformIDs, filter.PlatformMatch == models.MatchAll)
+	query = platformAssociation.exclude(query, filter.ExcludePlatforms, filter.ExcludePlatformIDs)
+	query = developerAssociation.filter(query, nil, filter.DeveloperIDs, false)
+	query = publisherAssociation.filter(query, nil, filter.PublisherIDs, false)
+	query = applyReleaseFilters(query, filter)
+	query = applyAgeRatingFilters(query, filter)
+	query = applyRequirementFilters(query, filter)
+	query = applyAttributeFilters(query, filter)
+	query = applyExternalIDFilter(query, filter)
+	query = tagAssociation.filter(query, filter.Tags, nil, filter.TagMatch == models.MatchAll)
+	if len(filter.CompanyIDs) > 0 {
+		query = query.Where(
+			developerAssociation.exists("companies.id IN ?")+" OR "+publisherAssociation.exists("companies.id IN ?"),
+			filter.CompanyIDs, filter.CompanyIDs,
+		)
 	}
 	
-	return response, nil
+	return query
 }
 
 func (r *PostgresGameRepository) CreateGenre(ctx context.Context, genre *models.Genre) error {
-	return r.db.WithContext(ctx).Create(genre).Error
+	return translateError(r.db.WithContext(ctx).Create(genre).Error, "genre")
 }
 
 func (r *PostgresGameRepository) GetAllGenres(ctx context.Context) ([]models.Genre, error) {
 	var genres []models.Genre
 	err := r.db.WithContext(ctx).Find(&genres).Error
-	return genres, err
+	return genres, translateError(err, "genre")
+}
+
+func (r *PostgresGameRepository) FindGenresByIDs(ctx context.Context, ids []uint) ([]models.Genre, error) {
+	var genres []models.Genre
+	err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&genres).Error
+	return genres, translateError(err, "genre")
+}
+
+func (r *PostgresGameRepository) GetGenreByID(ctx context.Context, id uint) (*models.Genre, error) {
+	var genre models.Genre
+	err := r.db.WithContext(ctx).First(&genre, id).Error
+	if err != nil {
+		return nil, translateError(err, "genre")
+	}
+	return &genre, nil
+}
+
+func (r *PostgresGameRepository) UpdateGenre(ctx context.Context, genre *models.Genre) error {
+	return translateError(r.db.WithContext(ctx).Save(genre).Error, "genre")
+}
+
+// DeleteGenre supprime un genre. S'il est encore utilisé par des jeux, la
+// suppression est refusée, sauf si cascade est demandé : le genre est alors
+// retiré de ces jeux.
+func (r *PostgresGameRepository) DeleteGenre(ctx context.Context, id uint, cascade bool) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		count, err := genreAssociation.countGames(tx, id)
+		if err != nil {
+			return err
+		}
+		if count > 0 && !cascade {
+			return apperrors.Conflict("genre is still used by %d games", count)
+		}
+
+		err = genreAssociation.unlink(tx, id)
+		if err != nil {
+			return err
+		}
+
+		return deleteByID(tx, &models.Genre{}, id, "genre")
+	})
+	return translateError(err, "genre")
+}
+
+// MergeGenres reporte les jeux du genre source sur le genre cible, puis
+// supprime le genre source.
+func (r *PostgresGameRepository) MergeGenres(ctx context.Context, sourceID, targetID uint) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var genres []models.Genre
+		err := tx.Where("id IN ?", []uint{sourceID, targetID}).Find(&genres).Error
+		if err != nil {
+			return err
+		}
+		if len(genres) != 2 {
+			return apperrors.NotFound("genre not found")
+		}
+
+		err = genreAssociation.relink(tx, sourceID, targetID)
+		if err != nil {
+			return err
+		}
+
+		return deleteByID(tx, &models.Genre{}, sourceID, "genre")
+	})
+	return translateError(err, "genre")
 }
 
 func (r *PostgresGameRepository) CreatePlatform(ctx context.Context, platform *models.Platform) error {
-	return r.db.WithContext(ctx).Create(platform).Error
+	return translateError(r.db.WithContext(ctx).Create(platform).Error, "platform")
 }
 
 func (r *PostgresGameRepository) GetAllPlatforms(ctx context.Context) ([]models.Platform, error) {
 	var platforms []models.Platform
 	err := r.db.WithContext(ctx).Find(&platforms).Error
-	return platforms, err
+	return platforms, translateError(err, "platform")
+}
+
+func (r *PostgresGameRepository) FindPlatformsByIDs(ctx context.Context, ids []uint) ([]models.Platform, error) {
+	var platforms []models.Platform
+	err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&platforms).Error
+	return platforms, translateError(err, "platform")
+}
+
+func (r *PostgresGameRepository) GetPlatformByID(ctx context.Context, id uint) (*models.Platform, error) {
+	var platform models.Platform
+	err := r.db.WithContext(ctx).First(&platform, id).Error
+	if err != nil {
+		return nil, translateError(err, "platform")
+	}
+	return &platform, nil
+}
+
+func (r *PostgresGameRepository) UpdatePlatform(ctx context.Context, platform *models.Platform) error {
+	return translateError(r.db.WithContext(ctx).Save(platform).Error, "platform")
+}
+
+// DeletePlatform supprime une plateforme. Si des jeux, des prix ou des
+// configurations requises y font encore référence, la suppression est refusée,
+// sauf si cascade est demandé : la plateforme est alors retirée des jeux et
+// ses prix et configurations spécifiques supprimés.
+func (r *PostgresGameRepository) DeletePlatform(ctx context.Context, id uint, cascade bool) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		count, err := platformAssociation.countGames(tx, id)
+		if err != nil {
+			return err
+		}
+
+		var prices int64
+		err = tx.Model(&models.GamePrice{}).Where("platform_id = ?", id).Count(&prices).Error
+		if err != nil {
+			return err
+		}
+		var requirements int64
+		err = tx.Model(&models.SystemRequirements{}).Where("platform_id = ?", id).Count(&requirements).Error
+		if err != nil {
+			return err
+		}
+		var releases int64
+		err = tx.Model(&models.GameRelease{}).Where("platform_id = ?", id).Count(&releases).Error
+		if err != nil {
+			return err
+		}
+		if (count > 0 || prices > 0 || requirements > 0 || releases > 0) && !cascade {
+			return apperrors.Conflict("platform is still used by %d games, %d prices, %d system requirements and %d releases", count, prices, requirements, releases)
+		}
+
+		err = platformAssociation.unlink(tx, id)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("platform_id = ?", id).Delete(&models.GamePrice{}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("platform_id = ?", id).Delete(&models.SystemRequirements{}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("platform_id = ?", id).Delete(&models.GameRelease{}).Error
+		if err != nil {
+			return err
+		}
+
+		return deleteByID(tx, &models.Platform{}, id, "platform")
+	})
+	return translateError(err, "platform")
+}
+
+// MergePlatforms reporte les jeux, les prix, les sorties et les configurations
+// requises de la plateforme source sur la plateforme cible, puis supprime la
+// plateforme source. Lorsqu'un jeu a déjà un prix ou une sortie pour la cible
+// dans la même région, ou une configuration de même niveau, celle de la cible
+// est conservée.
+func (r *PostgresGameRepository) MergePlatforms(ctx context.Context, sourceID, targetID uint) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var platforms []models.Platform
+		err := tx.Where("id IN ?", []uint{sourceID, targetID}).Find(&platforms).Error
+		if err != nil {
+			return err
+		}
+		if len(platforms) != 2 {
+			return apperrors.NotFound("platform not found")
+		}
+
+		err = platformAssociation.relink(tx, sourceID, targetID)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Exec(`DELETE FROM game_prices duplicate WHERE duplicate.platform_id = ? AND EXISTS (
+			SELECT 1 FROM game_prices existing
+			WHERE existing.game_id = duplicate.game_id AND existing.platform_id = ? AND existing.region = duplicate.region)`,
+			sourceID, targetID,
+		).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&models.GamePrice{}).Where("platform_id = ?", sourceID).Update("platform_id", targetID).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Exec(`DELETE FROM system_requirements duplicate WHERE duplicate.platform_id = ? AND EXISTS (
+			SELECT 1 FROM system_requirements existing
+			WHERE existing.game_id = duplicate.game_id AND existing.platform_id = ? AND existing.tier = duplicate.tier)`,
+			sourceID, targetID,
+		).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&models.SystemRequirements{}).Where("platform_id = ?", sourceID).Update("platform_id", targetID).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Exec(`DELETE FROM game_releases duplicate WHERE duplicate.platform_id = ? AND EXISTS (
+			SELECT 1 FROM game_releases existing
+			WHERE existing.game_id = duplicate.game_id AND existing.platform_id = ? AND existing.region = duplicate.region)`,
+			sourceID, targetID,
+		).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&models.GameRelease{}).Where("platform_id = ?", sourceID).Update("platform_id", targetID).Error
+		if err != nil {
+			return err
+		}
+
+		return deleteByID(tx, &models.Platform{}, sourceID, "platform")
+	})
+	return translateError(err, "platform")
+}
+
+func deleteByID(tx *gorm.DB, model interface{}, id uint, entity string) error {
+	result := tx.Delete(model, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("%s not found", entity)
+	}
+	return nil
+}
+
+func (r *PostgresGameRepository) CreateCompany(ctx context.Context, company *models.Company) error {
+	return translateError(r.db.WithContext(ctx).Create(company).Error, "company")
+}
+
+func (r *PostgresGameRepository) GetAllCompanies(ctx context.Context) ([]models.Company, error) {
+	var companies []models.Company
+	err := r.db.WithContext(ctx).Order("name").Find(&companies).Error
+	return companies, translateError(err, "company")
+}
+
+func (r *PostgresGameRepository) GetCompanyByID(ctx context.Context, id uint) (*models.Company, error) {
+	var company models.Company
+	err := r.db.WithContext(ctx).First(&company, id).Error
+	if err != nil {
+		return nil, translateError(err, "company")
+	}
+	return &company, nil
+}
+
+func (r *PostgresGameRepository) FindCompaniesByIDs(ctx context.Context, ids []uint) ([]models.Company, error) {
+	var companies []models.Company
+	err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&companies).Error
+	return companies, translateError(err, "company")
+}
+
+func (r *PostgresGameRepository) UpdateCompany(ctx context.Context, company *models.Company) error {
+	return translateError(r.db.WithContext(ctx).Save(company).Error, "company")
+}
+
+// DeleteCompany supprime une société. Si des jeux la citent encore comme
+// développeur ou éditeur, la suppression est refusée, sauf si cascade est
+// demandé : la société est alors retirée de ces jeux.
+func (r *PostgresGameRepository) DeleteCompany(ctx context.Context, id uint, cascade bool) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		developed, err := developerAssociation.countGames(tx, id)
+		if err != nil {
+			return err
+		}
+
+		published, err := publisherAssociation.countGames(tx, id)
+		if err != nil {
+			return err
+		}
+		if (developed > 0 || published > 0) && !cascade {
+			return apperrors.Conflict("company is still credited on %d games as developer and %d as publisher", developed, published)
+		}
+
+		err = developerAssociation.unlink(tx, id)
+		if err != nil {
+			return err
+		}
+
+		err = publisherAssociation.unlink(tx, id)
+		if err != nil {
+			return err
+		}
+
+		return deleteByID(tx, &models.Company{}, id, "company")
+	})
+	return translateError(err, "company")
+}
+
+// applyPriceRange retient les jeux dont le prix de base ou l'un des prix par
+// plateforme ou par région se situe dans la fourchette demandée. Avec une
+// devise, seuls les prix spécifiques dans cette devise sont comparés.
+func applyPriceRange(query *gorm.DB, filter *models.GameFilter) *gorm.DB {
+	if filter.MinPrice == nil && filter.MaxPrice == nil {
+		return query
+	}
+
+	var base, scoped []string
+	var baseVars, scopedVars []interface{}
+	if filter.MinPrice != nil {
+		base = append(base, "games.price >= ?")
+		scoped = append(scoped, "game_prices.amount >= ?")
+		baseVars = append(baseVars, *filter.MinPrice)
+		scopedVars = append(scopedVars, *filter.MinPrice)
+	}
+	if filter.MaxPrice != nil {
+		base = append(base, "games.price <= ?")
+		scoped = append(scoped, "game_prices.amount <= ?")
+		baseVars = append(baseVars, *filter.MaxPrice)
+		scopedVars = append(scopedVars, *filter.MaxPrice)
+	}
+	if filter.Currency != "" {
+		scoped = append(scoped, "game_prices.currency = ?")
+		scopedVars = append(scopedVars, strings.ToUpper(filter.Currency))
+	}
+
+	return query.Where(
+		"(("+strings.Join(base, " AND ")+") OR EXISTS (SELECT 1 FROM game_prices WHERE game_prices.game_id = games.id AND "+strings.Join(scoped, " AND ")+"))",
+		append(baseVars, scopedVars...)...,
+	)
 }
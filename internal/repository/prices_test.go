@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceRangeIncludesScopedPrices(t *testing.T) {
+	minPrice, maxPrice := 10.0, 20.0
+
+	t.Run("prix de base ou prix spécifique", func(t *testing.T) {
+		r, recorder := newTestRepository(t)
+
+		_, err := r.List(t.Context(), &models.GameFilter{MinPrice: &minPrice, MaxPrice: &maxPrice})
+		require.NoError(t, err)
+
+		query := gamesQuery(t, recorder.statements)
+		assert.Contains(t, query, "((games.price >= 10 AND games.price <= 20) OR EXISTS (SELECT 1 FROM game_prices WHERE game_prices.game_id = games.id AND game_prices.amount >= 10 AND game_prices.amount <= 20))")
+	})
+
+	t.Run("prix spécifiques dans la devise demandée", func(t *testing.T) {
+		r, recorder := newTestRepository(t)
+
+		_, err := r.List(t.Context(), &models.GameFilter{MaxPrice: &maxPrice, Currency: "eur"})
+		require.NoError(t, err)
+
+		query := gamesQuery(t, recorder.statements)
+		assert.Contains(t, query, "((games.price <= 20) OR EXISTS (SELECT 1 FROM game_prices WHERE game_prices.game_id = games.id AND game_prices.amount <= 20 AND game_prices.currency = 'EUR'))")
+		assert.Contains(t, query, "games.currency = 'EUR'")
+	})
+
+	t.Run("sans fourchette", func(t *testing.T) {
+		r, recorder := newTestRepository(t)
+
+		_, err := r.List(t.Context(), &models.GameFilter{Currency: "EUR"})
+		require.NoError(t, err)
+
+		assert.NotContains(t, gamesQuery(t, recorder.statements), "game_prices")
+	})
+}
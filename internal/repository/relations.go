@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+	"gorm.io/gorm"
+)
+
+func (r *PostgresGameRepository) CreateFranchise(ctx context.Context, franchise *models.Franchise) error {
+	return translateError(r.db.WithContext(ctx).Create(franchise).Error, "franchise")
+}
+
+func (r *PostgresGameRepository) GetAllFranchises(ctx context.Context) ([]models.Franchise, error) {
+	var franchises []models.Franchise
+	err := r.db.WithContext(ctx).Order("name").Find(&franchises).Error
+	return franchises, translateError(err, "franchise")
+}
+
+func (r *PostgresGameRepository) GetFranchiseByID(ctx context.Context, id uint) (*models.Franchise, error) {
+	var franchise models.Franchise
+	err := r.db.WithContext(ctx).First(&franchise, id).Error
+	if err != nil {
+		return nil, translateError(err, "franchise")
+	}
+	return &franchise, nil
+}
+
+func (r *PostgresGameRepository) UpdateFranchise(ctx context.Context, franchise *models.Franchise) error {
+	return translateError(r.db.WithContext(ctx).Save(franchise).Error, "franchise")
+}
+
+// DeleteFranchise supprime une franchise ; ses jeux sont conservés mais n'y
+// sont plus rattachés.
+func (r *PostgresGameRepository) DeleteFranchise(ctx context.Context, id uint) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Unscoped().Model(&models.Game{}).Where("franchise_id = ?", id).Update("franchise_id", nil).Error
+		if err != nil {
+			return err
+		}
+
+		return deleteByID(tx, &models.Franchise{}, id, "franchise")
+	})
+	return translateError(err, "franchise")
+}
+
+func (r *PostgresGameRepository) CreateRelation(ctx context.Context, relation *models.GameRelation) error {
+	return translateError(r.db.WithContext(ctx).Omit("Game", "RelatedGame").Create(relation).Error, "relation")
+}
+
+func (r *PostgresGameRepository) DeleteRelation(ctx context.Context, gameID, relationID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("game_id = ? OR related_game_id = ?", gameID, gameID).
+		Delete(&models.GameRelation{}, relationID)
+	if result.Error != nil {
+		return translateError(result.Error, "relation")
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("relation not found")
+	}
+	return nil
+}
+
+// GetRelatedGames charge les autres jeux de la franchise du jeu ainsi que ses
+// relations dans les deux sens. Les relations vers des jeux supprimés sont
+// ignorées.
+func (r *PostgresGameRepository) GetRelatedGames(ctx context.Context, game *models.Game) (*models.RelatedGames, error) {
+	related := &models.RelatedGames{
+		Series:           []models.Game{},
+		Relations:        []models.GameRelation{},
+		InverseRelations: []models.GameRelation{},
+	}
+	db := r.db.WithContext(ctx)
+
+	if game.FranchiseID != nil {
+		err := db.Where("franchise_id = ? AND id <> ?", *game.FranchiseID, game.ID).
+			Order("release_date, id").
+			Find(&related.Series).Error
+		if err != nil {
+			return nil, translateError(err, "game")
+		}
+	}
+
+	var relations []models.GameRelation
+	err := db.Preload("RelatedGame").Where("game_id = ?", game.ID).Order("type, id").Find(&relations).Error
+	if err != nil {
+		return nil, translateError(err, "relation")
+	}
+	for _, relation := range relations {
+		if relation.RelatedGame != nil {
+			related.Relations = append(related.Relations, relation)
+		}
+	}
+
+	var inverse []models.GameRelation
+	err = db.Preload("Game").Where("related_game_id = ?", game.ID).Order("type, id").Find(&inverse).Error
+	if err != nil {
+		return nil, translateError(err, "relation")
+	}
+	for _, relation := range inverse {
+		if relation.Game != nil {
+			related.InverseRelations = append(related.InverseRelations, relation)
+		}
+	}
+
+	return related, nil
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"strings"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	"gorm.io/gorm"
+)
+
+// releasePeriodEnd est la fin de la période couverte par une sortie : les
+// dates sont stockées au début de leur période (mois, trimestre, année), une
+// sortie prévue « en 2026 » reste donc à venir jusqu'au 31 décembre.
+const releasePeriodEnd = `CASE game_releases.precision
+	WHEN 'month' THEN game_releases.date + interval '1 month'
+	WHEN 'quarter' THEN game_releases.date + interval '3 months'
+	WHEN 'year' THEN game_releases.date + interval '1 year'
+	ELSE game_releases.date END`
+
+// applyReleaseFilters restreint la requête aux jeux ayant au moins une sortie
+// vérifiant tous les critères de sortie du filtre. Une sortie sans plateforme
+// (ou sans région) s'applique à toutes les plateformes (ou régions), et les
+// sorties annulées sont ignorées sauf si ce statut est demandé explicitement.
+// Une sortie à venir est annoncée ou repoussée et sa période n'est pas encore
+// écoulée (ou sa date est inconnue).
+func applyReleaseFilters(query *gorm.DB, filter *models.GameFilter) *gorm.DB {
+	var conditions []string
+	var vars []interface{}
+
+	if filter.ReleasePlatformID != nil {
+		conditions = append(conditions, "(game_releases.platform_id = ? OR game_releases.platform_id IS NULL)")
+		vars = append(vars, *filter.ReleasePlatformID)
+	}
+	if filter.ReleaseRegion != "" {
+		conditions = append(conditions, "(game_releases.region = ? OR game_releases.region = '')")
+		vars = append(vars, strings.ToUpper(filter.ReleaseRegion))
+	}
+	if filter.ReleasedAfter != nil {
+		conditions = append(conditions, "game_releases.date >= ?")
+		vars = append(vars, *filter.ReleasedAfter)
+	}
+	if filter.ReleasedBefore != nil {
+		conditions = append(conditions, "game_releases.date <= ?")
+		vars = append(vars, *filter.ReleasedBefore)
+	}
+	if filter.Upcoming {
+		conditions = append(conditions, "game_releases.status IN ? AND (game_releases.date IS NULL OR "+releasePeriodEnd+" > CURRENT_DATE)")
+		vars = append(vars, []string{models.ReleaseAnnounced, models.ReleaseDelayed})
+	}
+
+	if len(conditions) == 0 && filter.ReleaseStatus == "" {
+		return query
+	}
+
+	if filter.ReleaseStatus != "" {
+		conditions = append(conditions, "game_releases.status = ?")
+		vars = append(vars, filter.ReleaseStatus)
+	} else {
+		conditions = append(conditions, "game_releases.status <> ?")
+		vars = append(vars, models.ReleaseCancelled)
+	}
+
+	return query.Where(
+		"EXISTS (SELECT 1 FROM game_releases WHERE game_releases.game_id = games.id AND "+strings.Join(conditions, " AND ")+")",
+		vars...,
+	)
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpcomingComparesReleasePeriodWithToday(t *testing.T) {
+	r, recorder := newTestRepository(t)
+
+	_, err := r.List(t.Context(), &models.GameFilter{Upcoming: true})
+	require.NoError(t, err)
+
+	query := gamesQuery(t, recorder.statements)
+	assert.Contains(t, query, "game_releases.status IN ('announced','delayed')")
+	assert.Contains(t, query, "(game_releases.date IS NULL OR CASE game_releases.precision")
+	assert.Contains(t, query, "WHEN 'year' THEN game_releases.date + interval '1 year'")
+	assert.Contains(t, query, "ELSE game_releases.date END > CURRENT_DATE)")
+	assert.Contains(t, query, "game_releases.status <> 'cancelled'")
+}
+
+func TestReleaseFiltersWithoutUpcomingIgnoreToday(t *testing.T) {
+	r, recorder := newTestRepository(t)
+
+	_, err := r.List(t.Context(), &models.GameFilter{ReleaseStatus: models.ReleaseAnnounced})
+	require.NoError(t, err)
+
+	query := gamesQuery(t, recorder.statements)
+	assert.Contains(t, query, "game_releases.status = 'announced'")
+	assert.NotContains(t, query, "CURRENT_DATE")
+}
@@ -11,11 +11,72 @@ type GameRepository interface {
 	GetByID(ctx context.Context, id uint) (*models.Game, error)
 	Update(ctx context.Context, game *models.Game) error
 	Delete(ctx context.Context, id uint) error
+	Restore(ctx context.Context, id uint) error
 	List(ctx context.Context, filter *models.GameFilter) (*models.GameResponse, error)
+	AutocompleteTitles(ctx context.Context, prefix string, limit int) ([]models.TitleSuggestion, error)
+	AddGenreToGame(ctx context.Context, gameID, genreID uint) error
+	RemoveGenreFromGame(ctx context.Context, gameID, genreID uint) error
+	AddPlatformToGame(ctx context.Context, gameID, platformID uint) error
+	RemovePlatformFromGame(ctx context.Context, gameID, platformID uint) error
 	
 	CreateGenre(ctx context.Context, genre *models.Genre) error
 	GetAllGenres(ctx context.Context) ([]models.Genre, error)
+	FindGenresByIDs(ctx context.Context, ids []uint) ([]models.Genre, error)
+	GetGenreByID(ctx context.Context, id uint) (*models.Genre, error)
+	UpdateGenre(ctx context.Context, genre *models.Genre) error
+	DeleteGenre(ctx context.Context, id uint, cascade bool) error
+	MergeGenres(ctx context.Context, sourceID, targetID uint) error
 	
 	CreatePlatform(ctx context.Context, platform *models.Platform) error
 	GetAllPlatforms(ctx context.Context) ([]models.Platform, error)
+	FindPlatformsByIDs(ctx context.Context, ids []uint) ([]models.Platform, error)
+	GetPlatformByID(ctx context.Context, id uint) (*models.Platform, error)
+	UpdatePlatform(ctx context.Context, platform *models.Platform) error
+	DeletePlatform(ctx context.Context, id uint, cascade bool) error
+	MergePlatforms(ctx context.Context, sourceID, targetID uint) error
+	
+	CreateCompany(ctx context.Context, company *models.Company) error
+	GetAllCompanies(ctx context.Context) ([]models.Company, error)
+	GetCompanyByID(ctx context.Context, id uint) (*models.Company, error)
+	FindCompaniesByIDs(ctx context.Context, ids []uint) ([]models.Company, error)
+	UpdateCompany(ctx context.Context, company *models.Company) error
+	DeleteCompany(ctx context.Context, id uint, cascade bool) error
+	
+	CreateFranchise(ctx context.Context, franchise *models.Franchise) error
+	GetAllFranchises(ctx context.Context) ([]models.Franchise, error)
+	GetFranchiseByID(ctx context.Context, id uint) (*models.Franchise, error)
+	UpdateFranchise(ctx context.Context, franchise *models.Franchise) error
+	DeleteFranchise(ctx context.Context, id uint) error
+	
+	CreateRelation(ctx context.Context, relation *models.GameRelation) error
+	DeleteRelation(ctx context.Context, gameID, relationID uint) error
+	GetRelatedGames(ctx context.Context, game *models.Game) (*models.RelatedGames, error)
+	
+	GetGameTranslations(ctx context.Context, gameID uint) ([]models.GameTranslation, error)
+	FindGameTranslations(ctx context.Context, gameIDs []uint, locales []string) ([]models.GameTranslation, error)
+	SaveGameTranslation(ctx context.Context, translation *models.GameTranslation) error
+	DeleteGameTranslation(ctx context.Context, gameID uint, locale string) error
+	
+	FindGameMedia(ctx context.Context, gameIDs []uint) ([]models.GameMedia, error)
+	AddGameMedia(ctx context.Context, media *models.GameMedia) error
+	ReorderGameMedia(ctx context.Context, gameID uint, mediaIDs []uint) error
+	DeleteGameMedia(ctx context.Context, gameID, mediaID uint) error
+	
+	CreateTag(ctx context.Context, tag *models.Tag) error
+	GetTagByID(ctx context.Context, id uint) (*models.Tag, error)
+	GetTagByName(ctx context.Context, name string) (*models.Tag, error)
+	GetTags(ctx context.Context, status string) ([]models.Tag, error)
+	UpdateTagStatus(ctx context.Context, id uint, status string) error
+	VoteGameTag(ctx context.Context, gameID, tagID uint, voter string) (*models.GameTag, error)
+	UnvoteGameTag(ctx context.Context, gameID, tagID uint, voter string) error
+	
+	GetGameByExternalID(ctx context.Context, provider, externalID string) (*models.Game, error)
+	FindExternalIDs(ctx context.Context, ids []models.GameExternalID) ([]models.GameExternalID, error)
+	
+	GetGameByUUID(ctx context.Context, uuid string) (*models.Game, error)
+	GetGameBySlug(ctx context.Context, slug string) (*models.Game, error)
+	FindGameIDByUUID(ctx context.Context, uuid string) (uint, error)
+	FindGameIDBySlug(ctx context.Context, slug string) (uint, error)
+	FindSlugRedirect(ctx context.Context, slug string) (*models.GameSlug, error)
+	FindSlugs(ctx context.Context, base string) ([]models.GameSlug, error)
 }
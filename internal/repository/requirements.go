@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"strings"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	"gorm.io/gorm"
+)
+
+// applyRequirementFilters retient les jeux dont la configuration minimale, sur
+// au moins une plateforme, tient dans la mémoire vive et l'espace disque
+// indiqués. Une quantité non renseignée ne satisfait pas le filtre
+// correspondant.
+func applyRequirementFilters(query *gorm.DB, filter *models.GameFilter) *gorm.DB {
+	var conditions []string
+	var vars []interface{}
+
+	if filter.MaxMemoryGB != nil {
+		conditions = append(conditions, "system_requirements.memory_mb > 0 AND system_requirements.memory_mb <= ?")
+		vars = append(vars, *filter.MaxMemoryGB*1024)
+	}
+	if filter.MaxStorageGB != nil {
+		conditions = append(conditions, "system_requirements.storage_mb > 0 AND system_requirements.storage_mb <= ?")
+		vars = append(vars, *filter.MaxStorageGB*1024)
+	}
+	if len(conditions) == 0 {
+		return query
+	}
+
+	vars = append([]interface{}{models.RequirementsMinimum}, vars...)
+	return query.Where(
+		"EXISTS (SELECT 1 FROM system_requirements WHERE system_requirements.game_id = games.id AND system_requirements.tier = ? AND "+strings.Join(conditions, " AND ")+")",
+		vars...,
+	)
+}
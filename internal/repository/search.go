@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	searchTsQuery               = "websearch_to_tsquery(?::regconfig, ?)"
+	titleSimilarityExpr         = "word_similarity(lower(?), lower(games.title))"
+	titleHighlightOptions       = "StartSel=<mark>, StopSel=</mark>, HighlightAll=true"
+	descriptionHighlightOptions = "StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=25, MinWords=8"
+)
+
+// applySearch restreint la requête aux jeux correspondant à la recherche plein
+// texte, en s'appuyant sur la colonne search_vector indexée (GIN).
+func (r *PostgresGameRepository) applySearch(query *gorm.DB, text string) *gorm.DB {
+	return query.Where("games.search_vector @@ "+searchTsQuery, r.searchLanguage, text)
+}
+
+// selectColumns ajoute aux colonnes du jeu le score de pertinence, les extraits
+// mis en évidence et la similarité de titre lorsque la recherche les utilise.
+func (r *PostgresGameRepository) selectColumns(query *gorm.DB, filter *models.GameFilter) *gorm.DB {
+	columns := []string{"games.*"}
+	var vars []interface{}
+
+	if filter.Query != "" {
+		columns = append(columns,
+			"ts_rank(games.search_vector, "+searchTsQuery+") AS relevance",
+			"ts_headline(?::regconfig, games.title, "+searchTsQuery+", ?) AS title_highlight",
+			"ts_headline(?::regconfig, coalesce(games.description, ''), "+searchTsQuery+", ?) AS description_highlight",
+		)
+		vars = append(vars,
+			r.searchLanguage, filter.Query,
+			r.searchLanguage, r.searchLanguage, filter.Query, titleHighlightOptions,
+			r.searchLanguage, r.searchLanguage, filter.Query, descriptionHighlightOptions,
+		)
+	}
+	if filter.Fuzzy && filter.Title != "" {
+		columns = append(columns, titleSimilarityExpr+" AS title_similarity")
+		vars = append(vars, filter.Title)
+	}
+
+	if len(vars) == 0 {
+		return query
+	}
+
+	return query.Select(strings.Join(columns, ", "), vars...)
+}
+
+// applyFuzzyTitle filtre les titres par similarité de trigrammes (pg_trgm), ce
+// qui tolère les fautes de frappe ("zelda breth").
+func applyFuzzyTitle(query *gorm.DB, title string) *gorm.DB {
+	return query.Where("lower(?) <% lower(games.title)", title)
+}
+
+func (r *PostgresGameRepository) AutocompleteTitles(ctx context.Context, prefix string, limit int) ([]models.TitleSuggestion, error) {
+	var suggestions []models.TitleSuggestion
+
+	likePrefix := escapeLike(strings.ToLower(prefix)) + "%"
+	err := r.db.WithContext(ctx).Model(&models.Game{}).
+		Select("games.id, games.title, "+titleSimilarityExpr+" AS similarity", prefix).
+		Where("lower(games.title) LIKE ? OR lower(?) <% lower(games.title)", likePrefix, prefix).
+		Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:  "lower(games.title) LIKE ? DESC, similarity DESC, games.title ASC",
+			Vars: []interface{}{likePrefix},
+		}}).
+		Limit(limit).
+		Scan(&suggestions).Error
+
+	return suggestions, translateError(err, "game")
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// gamesQuery retourne la requête principale de List (hors comptage et
+// préchargements).
+func gamesQuery(t *testing.T, statements []string) string {
+	t.Helper()
+	for _, statement := range statements {
+		if strings.HasPrefix(statement, "SELECT games.*") || strings.HasPrefix(statement, `SELECT * FROM "games"`) {
+			return statement
+		}
+	}
+	require.Fail(t, "requête principale introuvable", "%v", statements)
+	return ""
+}
+
+func TestFullTextSearchQuery(t *testing.T) {
+	r, recorder := newTestRepository(t)
+
+	_, err := r.List(t.Context(), &models.GameFilter{Query: "zelda"})
+	require.NoError(t, err)
+
+	query := gamesQuery(t, recorder.statements)
+	tsquery := "websearch_to_tsquery('french'::regconfig, 'zelda')"
+	assert.Contains(t, query, "games.search_vector @@ "+tsquery)
+	assert.Contains(t, query, "ts_rank(games.search_vector, "+tsquery+") AS relevance")
+	assert.Contains(t, query, "ts_headline('french'::regconfig, games.title, "+tsquery)
+	assert.Contains(t, query, "AS description_highlight")
+	assert.True(t, strings.HasSuffix(query, "ORDER BY ts_rank(games.search_vector, "+tsquery+") DESC, games.id DESC LIMIT 10"), query)
+}
+
+func TestFuzzyTitleQuery(t *testing.T) {
+	t.Run("similarité de trigrammes, triée par similarité", func(t *testing.T) {
+		r, recorder := newTestRepository(t)
+
+		_, err := r.List(t.Context(), &models.GameFilter{Title: "zelda breth", Fuzzy: true})
+		require.NoError(t, err)
+
+		query := gamesQuery(t, recorder.statements)
+		assert.Contains(t, query, "lower('zelda breth') <% lower(games.title)")
+		assert.Contains(t, query, "word_similarity(lower('zelda breth'), lower(games.title)) AS title_similarity")
+		assert.NotContains(t, query, "ILIKE")
+		assert.True(t, strings.HasSuffix(query, "ORDER BY word_similarity(lower('zelda breth'), lower(games.title)) DESC, games.id DESC LIMIT 10"), query)
+	})
+
+	t.Run("sans fuzzy, recherche par sous-chaîne", func(t *testing.T) {
+		r, recorder := newTestRepository(t)
+
+		_, err := r.List(t.Context(), &models.GameFilter{Title: "zelda"})
+		require.NoError(t, err)
+
+		query := gamesQuery(t, recorder.statements)
+		assert.Contains(t, query, "title ILIKE '%zelda%'")
+		assert.NotContains(t, query, "<%")
+		assert.NotContains(t, query, "word_similarity")
+	})
+}
+
+func TestAutocompleteQuery(t *testing.T) {
+	r, recorder := newTestRepository(t)
+
+	_, err := r.AutocompleteTitles(t.Context(), "50%_", 5)
+	require.NoError(t, err)
+
+	require.Len(t, recorder.statements, 1)
+	query := recorder.statements[0]
+	assert.Contains(t, query, `lower(games.title) LIKE '50\%\_%' OR lower('50%_') <% lower(games.title)`)
+	assert.True(t, strings.HasSuffix(query, "LIMIT 5"), query)
+}
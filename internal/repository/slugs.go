@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (r *PostgresGameRepository) GetGameByUUID(ctx context.Context, uuid string) (*models.Game, error) {
+	return r.findGame(ctx, "games.uuid = ?", uuid)
+}
+
+func (r *PostgresGameRepository) GetGameBySlug(ctx context.Context, slug string) (*models.Game, error) {
+	return r.findGame(ctx, "games.slug = ?", slug)
+}
+
+// FindGameIDByUUID et FindGameIDBySlug résolvent un identifiant public en
+// identifiant de jeu, jeux supprimés compris (pour permettre leur restauration).
+func (r *PostgresGameRepository) FindGameIDByUUID(ctx context.Context, uuid string) (uint, error) {
+	return r.findGameID(ctx, "uuid = ?", uuid)
+}
+
+func (r *PostgresGameRepository) FindGameIDBySlug(ctx context.Context, slug string) (uint, error) {
+	return r.findGameID(ctx, "slug = ?", slug)
+}
+
+func (r *PostgresGameRepository) findGameID(ctx context.Context, query string, args ...interface{}) (uint, error) {
+	var ids []uint
+	err := r.db.WithContext(ctx).Unscoped().Model(&models.Game{}).Where(query, args...).Limit(1).Pluck("id", &ids).Error
+	if err != nil {
+		return 0, translateError(err, "game")
+	}
+	if len(ids) == 0 {
+		return 0, apperrors.NotFound("game not found")
+	}
+	return ids[0], nil
+}
+
+// FindSlugRedirect cherche le jeu auquel appartenait un ancien slug.
+func (r *PostgresGameRepository) FindSlugRedirect(ctx context.Context, slug string) (*models.GameSlug, error) {
+	var redirect models.GameSlug
+	err := r.db.WithContext(ctx).Where("slug = ?", slug).First(&redirect).Error
+	if err != nil {
+		return nil, translateError(err, "game")
+	}
+	return &redirect, nil
+}
+
+// FindSlugs renvoie les slugs, courants (jeux supprimés compris) ou anciens,
+// égaux à base ou de la forme base-N, avec le jeu qui les détient.
+func (r *PostgresGameRepository) FindSlugs(ctx context.Context, base string) ([]models.GameSlug, error) {
+	var slugs []models.GameSlug
+	pattern := base + "-%"
+	err := r.db.WithContext(ctx).Raw(
+		`SELECT id AS game_id, slug FROM games WHERE slug = ? OR slug LIKE ?
+		UNION ALL
+		SELECT game_id, slug FROM game_slugs WHERE slug = ? OR slug LIKE ?`,
+		base, pattern, base, pattern,
+	).Scan(&slugs).Error
+	return slugs, translateError(err, "slug")
+}
+
+// archiveSlug conserve l'ancien slug du jeu lorsqu'il change, et retire de
+// l'historique le nouveau slug si le jeu l'avait déjà porté.
+func archiveSlug(tx *gorm.DB, game *models.Game) error {
+	var current []string
+	err := tx.Unscoped().Model(&models.Game{}).Where("id = ?", game.ID).Pluck("slug", &current).Error
+	if err != nil {
+		return err
+	}
+
+	if len(current) == 0 || current[0] == "" || current[0] == game.Slug {
+		return nil
+	}
+
+	err = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.GameSlug{GameID: game.ID, Slug: current[0]}).Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Where("game_id = ? AND slug = ?", game.ID, game.Slug).Delete(&models.GameSlug{}).Error
+}
@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// approvedTags restreint le préchargement des tags d'un jeu aux tags
+// approuvés, les plus votés en premier.
+func approvedTags(db *gorm.DB) *gorm.DB {
+	return db.Where("EXISTS (SELECT 1 FROM tags WHERE tags.id = game_tags.tag_id AND tags.status = ?)", models.TagApproved).
+		Order("game_tags.votes DESC, game_tags.tag_id")
+}
+
+func (r *PostgresGameRepository) CreateTag(ctx context.Context, tag *models.Tag) error {
+	return translateError(r.db.WithContext(ctx).Create(tag).Error, "tag")
+}
+
+func (r *PostgresGameRepository) GetTagByID(ctx context.Context, id uint) (*models.Tag, error) {
+	var tag models.Tag
+	err := r.db.WithContext(ctx).First(&tag, id).Error
+	if err != nil {
+		return nil, translateError(err, "tag")
+	}
+	return &tag, nil
+}
+
+func (r *PostgresGameRepository) GetTagByName(ctx context.Context, name string) (*models.Tag, error) {
+	var tag models.Tag
+	err := r.db.WithContext(ctx).Where("name = ?", name).First(&tag).Error
+	if err != nil {
+		return nil, translateError(err, "tag")
+	}
+	return &tag, nil
+}
+
+// GetTags liste les tags d'un statut donné avec leur popularité : le nombre de
+// jeux (non supprimés) qui les portent et le total de leurs votes.
+func (r *PostgresGameRepository) GetTags(ctx context.Context, status string) ([]models.Tag, error) {
+	var tags []models.Tag
+	err := r.db.WithContext(ctx).Model(&models.Tag{}).
+		Select("tags.*, COUNT(games.id) AS game_count, COALESCE(SUM(game_tags.votes) FILTER (WHERE games.id IS NOT NULL), 0) AS votes").
+		Joins("LEFT JOIN game_tags ON game_tags.tag_id = tags.id").
+		Joins("LEFT JOIN games ON games.id = game_tags.game_id AND games.deleted_at IS NULL").
+		Where("tags.status = ?", status).
+		Group("tags.id").
+		Order("game_count DESC, votes DESC, tags.name").
+		Find(&tags).Error
+	return tags, translateError(err, "tag")
+}
+
+func (r *PostgresGameRepository) UpdateTagStatus(ctx context.Context, id uint, status string) error {
+	result := r.db.WithContext(ctx).Model(&models.Tag{}).Where("id = ?", id).Update("status", status)
+	if result.Error != nil {
+		return translateError(result.Error, "tag")
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("tag not found")
+	}
+	return nil
+}
+
+// VoteGameTag enregistre le vote du votant pour le tag sur le jeu, en créant
+// l'association au premier vote, et retourne l'association à jour. Un votant
+// ne compte qu'une fois : un second vote est sans effet.
+func (r *PostgresGameRepository) VoteGameTag(ctx context.Context, gameID, tagID uint, voter string) (*models.GameTag, error) {
+	gameTag := &models.GameTag{GameID: gameID, TagID: tagID}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// L'upsert verrouille l'association jusqu'à la fin de la transaction,
+		// ce qui sérialise le recomptage des votes concurrents.
+		err := tx.Omit("Tag", "Voters").Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "game_id"}, {Name: "tag_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{"updated_at": gorm.Expr("NOW()")}),
+		}).Create(gameTag).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&models.GameTagVote{GameID: gameID, TagID: tagID, Voter: voter}).Error
+		if err != nil {
+			return err
+		}
+
+		return countTagVotes(tx, gameTag)
+	})
+	if err != nil {
+		return nil, translateError(err, "tag")
+	}
+	return gameTag, nil
+}
+
+// UnvoteGameTag retire le vote du votant pour le tag sur le jeu ;
+// l'association disparaît avec son dernier vote.
+func (r *PostgresGameRepository) UnvoteGameTag(ctx context.Context, gameID, tagID uint, voter string) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		gameTag := &models.GameTag{}
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("game_id = ? AND tag_id = ?", gameID, tagID).
+			First(gameTag).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NotFound("tag not found on this game")
+		}
+		if err != nil {
+			return err
+		}
+
+		result := tx.Where("game_id = ? AND tag_id = ? AND voter = ?", gameID, tagID, voter).Delete(&models.GameTagVote{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return apperrors.NotFound("vote not found")
+		}
+
+		err = countTagVotes(tx, gameTag)
+		if err != nil {
+			return err
+		}
+		if gameTag.Votes > 0 {
+			return nil
+		}
+		return tx.Delete(gameTag).Error
+	})
+	return translateError(err, "tag")
+}
+
+// countTagVotes recalcule le nombre de votes de l'association à partir des
+// votes enregistrés.
+func countTagVotes(tx *gorm.DB, gameTag *models.GameTag) error {
+	return tx.Model(gameTag).Clauses(clause.Returning{}).Updates(map[string]interface{}{
+		"votes": gorm.Expr(
+			"(SELECT COUNT(*) FROM game_tag_votes WHERE game_tag_votes.game_id = ? AND game_tag_votes.tag_id = ?)",
+			gameTag.GameID, gameTag.TagID,
+		),
+		"updated_at": gorm.Expr("NOW()"),
+	}).Error
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"testing"
+
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVoteGameTagRecordsVoter(t *testing.T) {
+	r, recorder := newTestRepository(t)
+
+	_, err := r.VoteGameTag(t.Context(), 1, 3, "e3b0c442")
+	require.NoError(t, err)
+
+	require.Len(t, recorder.statements, 3)
+	assert.Contains(t, recorder.statements[0], `INSERT INTO "game_tags"`)
+	assert.Contains(t, recorder.statements[0], `ON CONFLICT ("game_id","tag_id") DO UPDATE SET "updated_at"=NOW()`)
+	assert.Contains(t, recorder.statements[1], `INSERT INTO "game_tag_votes" ("game_id","tag_id","voter","created_at") VALUES (1,3,'e3b0c442',`)
+	assert.Contains(t, recorder.statements[1], "ON CONFLICT DO NOTHING")
+	assert.Contains(t, recorder.statements[2], `UPDATE "game_tags" SET "updated_at"=NOW(),"votes"=(SELECT COUNT(*) FROM game_tag_votes WHERE game_tag_votes.game_id = 1 AND game_tag_votes.tag_id = 3)`)
+	assert.Contains(t, recorder.statements[2], `WHERE "game_id" = 1 AND "tag_id" = 3`)
+}
+
+func TestUnvoteGameTagWithoutAssociation(t *testing.T) {
+	r, recorder := newTestRepository(t)
+
+	err := r.UnvoteGameTag(t.Context(), 1, 3, "e3b0c442")
+
+	assert.ErrorIs(t, err, apperrors.ErrNotFound)
+	require.NotEmpty(t, recorder.statements)
+	assert.Contains(t, recorder.statements[0], "FOR UPDATE")
+	for _, statement := range recorder.statements {
+		assert.NotContains(t, statement, "DELETE", "aucun vote n'est retiré sans association")
+	}
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// emptyDriver est un pilote database/sql dont chaque requête réussit sans
+// retourner de ligne : les tests exercent le chemin complet d'exécution de
+// GORM sans PostgreSQL et n'observent que le SQL produit.
+type emptyDriver struct{}
+
+type emptyConn struct{}
+
+type emptyStmt struct{}
+
+type emptyRows struct{}
+
+func (emptyDriver) Open(string) (driver.Conn, error) { return emptyConn{}, nil }
+
+func (emptyConn) Prepare(string) (driver.Stmt, error)        { return emptyStmt{}, nil }
+func (emptyConn) Close() error                               { return nil }
+func (emptyConn) Begin() (driver.Tx, error)                  { return emptyConn{}, nil }
+func (emptyConn) Commit() error                              { return nil }
+func (emptyConn) Rollback() error                            { return nil }
+func (emptyConn) CheckNamedValue(*driver.NamedValue) error   { return nil }
+func (emptyStmt) Close() error                               { return nil }
+func (emptyStmt) NumInput() int                              { return -1 }
+func (emptyStmt) Exec([]driver.Value) (driver.Result, error) { return driver.RowsAffected(0), nil }
+func (emptyStmt) Query([]driver.Value) (driver.Rows, error)  { return emptyRows{}, nil }
+func (emptyRows) Columns() []string                          { return nil }
+func (emptyRows) Close() error                               { return nil }
+func (emptyRows) Next([]driver.Value) error                  { return io.EOF }
+
+var registerEmptyDriver sync.Once
+
+// sqlRecorder capture les requêtes exécutées par GORM, avec leurs paramètres
+// interpolés.
+type sqlRecorder struct {
+	mu         sync.Mutex
+	statements []string
+}
+
+func (r *sqlRecorder) LogMode(logger.LogLevel) logger.Interface      { return r }
+func (r *sqlRecorder) Info(context.Context, string, ...interface{})  {}
+func (r *sqlRecorder) Warn(context.Context, string, ...interface{})  {}
+func (r *sqlRecorder) Error(context.Context, string, ...interface{}) {}
+
+func (r *sqlRecorder) Trace(_ context.Context, _ time.Time, fc func() (string, int64), _ error) {
+	sql, _ := fc()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.statements = append(r.statements, sql)
+}
+
+// newTestRepository retourne un dépôt branché sur emptyDriver, et
+// l'enregistreur des requêtes qu'il exécute.
+func newTestRepository(t *testing.T) (*PostgresGameRepository, *sqlRecorder) {
+	t.Helper()
+	registerEmptyDriver.Do(func() { sql.Register("empty", emptyDriver{}) })
+
+	conn, err := sql.Open("empty", "")
+	if err != nil {
+		t.Fatalf("ouverture de la base de test : %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	recorder := &sqlRecorder{}
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: conn}), &gorm.Config{
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+		Logger:                 recorder,
+	})
+	if err != nil {
+		t.Fatalf("ouverture de la base de test : %v", err)
+	}
+
+	return &PostgresGameRepository{db: db, searchLanguage: "french"}, recorder
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+	"gorm.io/gorm/clause"
+)
+
+func (r *PostgresGameRepository) GetGameTranslations(ctx context.Context, gameID uint) ([]models.GameTranslation, error) {
+	var translations []models.GameTranslation
+	err := r.db.WithContext(ctx).Where("game_id = ?", gameID).Order("locale").Find(&translations).Error
+	return translations, translateError(err, "translation")
+}
+
+// FindGameTranslations charge en une requête les traductions des jeux donnés
+// dans les langues données.
+func (r *PostgresGameRepository) FindGameTranslations(ctx context.Context, gameIDs []uint, locales []string) ([]models.GameTranslation, error) {
+	var translations []models.GameTranslation
+	if len(gameIDs) == 0 || len(locales) == 0 {
+		return translations, nil
+	}
+
+	err := r.db.WithContext(ctx).Where("game_id IN ? AND locale IN ?", gameIDs, locales).Find(&translations).Error
+	return translations, translateError(err, "translation")
+}
+
+// SaveGameTranslation crée la traduction, ou la remplace si le jeu en a déjà
+// une dans cette langue ; la ligne enregistrée (date de création d'origine
+// comprise) est relue dans translation.
+func (r *PostgresGameRepository) SaveGameTranslation(ctx context.Context, translation *models.GameTranslation) error {
+	err := r.db.WithContext(ctx).Omit("Game").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "game_id"}, {Name: "locale"}},
+		DoUpdates: clause.AssignmentColumns([]string{"title", "description", "updated_at"}),
+	}, clause.Returning{}).Create(translation).Error
+	return translateError(err, "translation")
+}
+
+func (r *PostgresGameRepository) DeleteGameTranslation(ctx context.Context, gameID uint, locale string) error {
+	result := r.db.WithContext(ctx).
+		Where("game_id = ? AND locale = ?", gameID, locale).
+		Delete(&models.GameTranslation{})
+	if result.Error != nil {
+		return translateError(result.Error, "translation")
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("translation not found")
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+)
+
+// ratingAges donne, pour chaque organisme, l'âge minimum correspondant à
+// chacune de ses classifications.
+var ratingAges = map[string]map[string]int{
+	"PEGI": {"3": 3, "7": 7, "12": 12, "16": 16, "18": 18},
+	"ESRB": {"EC": 3, "E": 6, "E10+": 10, "T": 13, "M": 17, "AO": 18},
+	"USK":  {"0": 0, "6": 6, "12": 12, "16": 16, "18": 18},
+	"CERO": {"A": 0, "B": 12, "C": 15, "D": 17, "Z": 18},
+}
+
+// applyAgeRatingDefaults normalise la classification et en déduit l'âge
+// minimum lorsqu'elle est connue.
+func applyAgeRatingDefaults(rating *models.AgeRating) {
+	rating.Board = strings.ToUpper(strings.TrimSpace(rating.Board))
+	rating.Rating = strings.ToUpper(strings.TrimSpace(rating.Rating))
+	for i, descriptor := range rating.Descriptors {
+		rating.Descriptors[i] = strings.TrimSpace(descriptor)
+	}
+
+	if age, ok := ratingAges[rating.Board][rating.Rating]; ok {
+		rating.MinimumAge = age
+	}
+}
+
+// validateAgeRatings vérifie que chaque classification existe pour son
+// organisme et qu'un organisme n'est cité qu'une fois.
+func validateAgeRatings(ratings []models.AgeRating) []apperrors.FieldError {
+	var fields []apperrors.FieldError
+	seen := make(map[string]bool, len(ratings))
+
+	for i, rating := range ratings {
+		ages, ok := ratingAges[rating.Board]
+		if !ok {
+			continue
+		}
+
+		if seen[rating.Board] {
+			fields = append(fields, apperrors.FieldError{
+				Field:   fmt.Sprintf("age_ratings[%d].board", i),
+				Message: fmt.Sprintf("le jeu a déjà une classification %s", rating.Board),
+			})
+		}
+		seen[rating.Board] = true
+
+		if _, ok := ages[rating.Rating]; rating.Rating != "" && !ok {
+			fields = append(fields, apperrors.FieldError{
+				Field:   fmt.Sprintf("age_ratings[%d].rating", i),
+				Message: fmt.Sprintf("la classification %s n'existe pas pour %s", rating.Rating, rating.Board),
+			})
+		}
+	}
+
+	return fields
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+)
+
+// normalizeValues met en minuscules et débarrasse de leurs espaces des valeurs
+// énumérées (modes de jeu, fonctions d'accessibilité), en supprimant les
+// doublons.
+func normalizeValues(values []string) []string {
+	if values == nil {
+		return nil
+	}
+
+	result := make([]string, 0, len(values))
+	seen := make(map[string]bool, len(values))
+	for _, value := range values {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if !seen[value] {
+			seen[value] = true
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// validateAttributes normalise les langues du jeu et vérifie qu'elles sont
+// valides, uniques et prises en charge d'au moins une manière, ainsi que la
+// cohérence du nombre de joueurs.
+func validateAttributes(game *models.Game) []apperrors.FieldError {
+	var fields []apperrors.FieldError
+	seen := make(map[string]bool, len(game.Languages))
+
+	for i := range game.Languages {
+		language := &game.Languages[i]
+		if language.Language == "" {
+			continue
+		}
+
+		normalized, ok := normalizeLocale(language.Language)
+		if !ok {
+			fields = append(fields, apperrors.FieldError{
+				Field:   fmt.Sprintf("languages[%d].language", i),
+				Message: fmt.Sprintf("%s n'est pas une langue valide (BCP 47, par exemple fr ou en-US)", language.Language),
+			})
+			continue
+		}
+		language.Language = normalized
+
+		if seen[normalized] {
+			fields = append(fields, apperrors.FieldError{
+				Field:   fmt.Sprintf("languages[%d].language", i),
+				Message: fmt.Sprintf("la langue %s est citée plusieurs fois", normalized),
+			})
+		}
+		seen[normalized] = true
+
+		if !language.Interface && !language.Audio && !language.Subtitles {
+			fields = append(fields, apperrors.FieldError{
+				Field:   fmt.Sprintf("languages[%d]", i),
+				Message: fmt.Sprintf("la langue %s doit être prise en charge dans l'interface, en audio ou en sous-titres", normalized),
+			})
+		}
+	}
+
+	if game.MinPlayers > 0 && game.MaxPlayers > 0 && game.MaxPlayers < game.MinPlayers {
+		fields = append(fields, apperrors.FieldError{
+			Field:   "max_players",
+			Message: "le nombre maximum de joueurs doit être supérieur ou égal au nombre minimum",
+		})
+	}
+
+	return fields
+}
+
+// normalizeLanguageFilter ramène les langues d'un filtre à leur forme
+// canonique et signale celles qui sont invalides.
+func normalizeLanguageFilter(field string, languages []string) []apperrors.FieldError {
+	var fields []apperrors.FieldError
+	for i, language := range languages {
+		normalized, ok := normalizeLocale(language)
+		if !ok {
+			fields = append(fields, apperrors.FieldError{
+				Field:   fmt.Sprintf("%s[%d]", field, i),
+				Message: fmt.Sprintf("%s n'est pas une langue valide (BCP 47, par exemple fr ou en-US)", language),
+			})
+			continue
+		}
+		languages[i] = normalized
+	}
+	return fields
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+func normalizeExternalID(id *models.GameExternalID) {
+	id.Provider = strings.ToLower(strings.TrimSpace(id.Provider))
+	id.ExternalID = strings.TrimSpace(id.ExternalID)
+}
+
+// validateExternalIDs vérifie qu'un identifiant externe n'est cité qu'une fois
+// et qu'il n'est pas déjà associé à un autre jeu.
+func (s *gameService) validateExternalIDs(ctx context.Context, game *models.Game) ([]apperrors.FieldError, error) {
+	var fields []apperrors.FieldError
+	if len(game.ExternalIDs) == 0 {
+		return fields, nil
+	}
+
+	seen := make(map[string]bool, len(game.ExternalIDs))
+	for i, id := range game.ExternalIDs {
+		key := id.Provider + "/" + id.ExternalID
+		if id.ExternalID != "" && seen[key] {
+			fields = append(fields, apperrors.FieldError{
+				Field:   fmt.Sprintf("external_ids[%d].external_id", i),
+				Message: fmt.Sprintf("l'identifiant %s %s est cité plusieurs fois", id.Provider, id.ExternalID),
+			})
+		}
+		seen[key] = true
+	}
+
+	existing, err := s.repo.FindExternalIDs(ctx, game.ExternalIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	owners := make(map[string]uint, len(existing))
+	for _, id := range existing {
+		owners[id.Provider+"/"+id.ExternalID] = id.GameID
+	}
+	for i, id := range game.ExternalIDs {
+		owner, ok := owners[id.Provider+"/"+id.ExternalID]
+		if ok && owner != game.ID {
+			fields = append(fields, apperrors.FieldError{
+				Field:   fmt.Sprintf("external_ids[%d].external_id", i),
+				Message: fmt.Sprintf("l'identifiant %s %s est déjà associé au jeu %d", id.Provider, id.ExternalID, owner),
+			})
+		}
+	}
+
+	return fields, nil
+}
+
+func (s *gameService) GetGameByExternalID(ctx context.Context, provider, externalID string) (*models.Game, error) {
+	id := models.GameExternalID{Provider: provider, ExternalID: externalID}
+	normalizeExternalID(&id)
+
+	err := invalid(validateStruct(&id))
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"provider":    id.Provider,
+		"external_id": id.ExternalID,
+	}).Info("Récupération d'un jeu par identifiant externe")
+	return s.repo.GetGameByExternalID(ctx, id.Provider, id.ExternalID)
+}
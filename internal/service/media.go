@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+func (s *gameService) GetGameMedia(ctx context.Context, gameID uint) ([]models.GameMedia, error) {
+	_, err := s.repo.GetByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.FindGameMedia(ctx, []uint{gameID})
+}
+
+// EmbedMedia renseigne la galerie de chacun des jeux (include=media).
+func (s *gameService) EmbedMedia(ctx context.Context, games []models.Game) error {
+	if len(games) == 0 {
+		return nil
+	}
+
+	ids := make([]uint, 0, len(games))
+	for _, game := range games {
+		ids = append(ids, game.ID)
+	}
+
+	media, err := s.repo.FindGameMedia(ctx, ids)
+	if err != nil {
+		s.logger.WithError(err).Error("Erreur lors de la récupération des médias")
+		return err
+	}
+
+	byGame := make(map[uint][]models.GameMedia)
+	for _, item := range media {
+		byGame[item.GameID] = append(byGame[item.GameID], item)
+	}
+	for i := range games {
+		games[i].Media = byGame[games[i].ID]
+		if games[i].Media == nil {
+			games[i].Media = []models.GameMedia{}
+		}
+	}
+	return nil
+}
+
+func (s *gameService) AddGameMedia(ctx context.Context, media *models.GameMedia) error {
+	media.Type = strings.ToLower(strings.TrimSpace(media.Type))
+	media.URL = strings.TrimSpace(media.URL)
+	media.Caption = strings.TrimSpace(media.Caption)
+
+	err := invalid(validateStruct(media))
+	if err != nil {
+		return err
+	}
+
+	_, err = s.repo.GetByID(ctx, media.GameID)
+	if err != nil {
+		return err
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"id":   media.GameID,
+		"type": media.Type,
+	}).Info("Ajout d'un média à un jeu")
+	return s.repo.AddGameMedia(ctx, media)
+}
+
+// ReorderGameMedia réordonne la galerie d'un jeu : mediaIDs doit contenir
+// chacun de ses médias exactement une fois, dans le nouvel ordre.
+func (s *gameService) ReorderGameMedia(ctx context.Context, gameID uint, mediaIDs []uint) ([]models.GameMedia, error) {
+	_, err := s.repo.GetByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.repo.FindGameMedia(ctx, []uint{gameID})
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uint]models.GameMedia, len(current))
+	for _, media := range current {
+		byID[media.ID] = media
+	}
+
+	var fields []apperrors.FieldError
+	seen := make(map[uint]bool, len(mediaIDs))
+	for i, id := range mediaIDs {
+		field := fmt.Sprintf("media_ids[%d]", i)
+		switch {
+		case seen[id]:
+			fields = append(fields, apperrors.FieldError{Field: field, Message: fmt.Sprintf("le média %d est cité plusieurs fois", id)})
+		case byID[id].ID == 0:
+			fields = append(fields, apperrors.FieldError{Field: field, Message: fmt.Sprintf("le média %d n'appartient pas au jeu", id)})
+		}
+		seen[id] = true
+	}
+	if len(fields) == 0 && len(mediaIDs) != len(current) {
+		fields = append(fields, apperrors.FieldError{
+			Field:   "media_ids",
+			Message: fmt.Sprintf("tous les médias du jeu doivent être cités (%d attendus, %d reçus)", len(current), len(mediaIDs)),
+		})
+	}
+	err = invalid(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.WithField("id", gameID).Info("Réorganisation de la galerie d'un jeu")
+	err = s.repo.ReorderGameMedia(ctx, gameID, mediaIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	reordered := make([]models.GameMedia, 0, len(mediaIDs))
+	for position, id := range mediaIDs {
+		media := byID[id]
+		media.Position = position
+		reordered = append(reordered, media)
+	}
+	return reordered, nil
+}
+
+func (s *gameService) RemoveGameMedia(ctx context.Context, gameID, mediaID uint) error {
+	s.logger.WithFields(logrus.Fields{
+		"id":       gameID,
+		"media_id": mediaID,
+	}).Info("Suppression d'un média d'un jeu")
+	return s.repo.DeleteGameMedia(ctx, gameID, mediaID)
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+)
+
+func applyRequirementsDefaults(requirements *models.SystemRequirements) {
+	requirements.Tier = strings.ToLower(strings.TrimSpace(requirements.Tier))
+	if requirements.Tier == "" {
+		requirements.Tier = models.RequirementsMinimum
+	}
+	requirements.OS = strings.TrimSpace(requirements.OS)
+	requirements.CPU = strings.TrimSpace(requirements.CPU)
+	requirements.GPU = strings.TrimSpace(requirements.GPU)
+	requirements.DirectXVersion = strings.TrimSpace(requirements.DirectXVersion)
+}
+
+// validateSystemRequirements vérifie qu'un jeu n'a qu'une configuration par
+// plateforme et par niveau.
+func validateSystemRequirements(requirements []models.SystemRequirements) []apperrors.FieldError {
+	var fields []apperrors.FieldError
+	seen := make(map[string]bool, len(requirements))
+
+	for i, requirement := range requirements {
+		key := fmt.Sprintf("%d/%s", requirement.PlatformID, requirement.Tier)
+		if requirement.PlatformID != 0 && seen[key] {
+			fields = append(fields, apperrors.FieldError{
+				Field:   fmt.Sprintf("system_requirements[%d].tier", i),
+				Message: fmt.Sprintf("le jeu a déjà une configuration %s pour la plateforme %d", requirement.Tier, requirement.PlatformID),
+			})
+		}
+		seen[key] = true
+	}
+
+	return fields
+}
@@ -9,13 +9,67 @@ import (
 type GameService interface {
 	CreateGame(ctx context.Context, game *models.Game) error
 	GetGameByID(ctx context.Context, id uint) (*models.Game, error)
+	GetGameByRef(ctx context.Context, ref string) (*models.Game, bool, error)
+	ResolveGameRef(ctx context.Context, ref string) (uint, error)
 	UpdateGame(ctx context.Context, game *models.Game) error
+	PatchGame(ctx context.Context, id uint, patch []byte) (*models.Game, error)
 	DeleteGame(ctx context.Context, id uint) error
+	RestoreGame(ctx context.Context, id uint) (*models.Game, error)
 	ListGames(ctx context.Context, filter *models.GameFilter) (*models.GameResponse, error)
+	AutocompleteTitles(ctx context.Context, prefix string, limit int) ([]models.TitleSuggestion, error)
+	AddGenreToGame(ctx context.Context, gameID, genreID uint) (*models.Game, error)
+	RemoveGenreFromGame(ctx context.Context, gameID, genreID uint) (*models.Game, error)
+	AddPlatformToGame(ctx context.Context, gameID, platformID uint) (*models.Game, error)
+	RemovePlatformFromGame(ctx context.Context, gameID, platformID uint) (*models.Game, error)
 	
 	CreateGenre(ctx context.Context, genre *models.Genre) error
 	GetAllGenres(ctx context.Context) ([]models.Genre, error)
+	GetGenreByID(ctx context.Context, id uint) (*models.Genre, error)
+	UpdateGenre(ctx context.Context, genre *models.Genre) error
+	DeleteGenre(ctx context.Context, id uint, cascade bool) error
+	MergeGenres(ctx context.Context, sourceID, targetID uint) (*models.Genre, error)
 	
 	CreatePlatform(ctx context.Context, platform *models.Platform) error
 	GetAllPlatforms(ctx context.Context) ([]models.Platform, error)
+	GetPlatformByID(ctx context.Context, id uint) (*models.Platform, error)
+	UpdatePlatform(ctx context.Context, platform *models.Platform) error
+	DeletePlatform(ctx context.Context, id uint, cascade bool) error
+	MergePlatforms(ctx context.Context, sourceID, targetID uint) (*models.Platform, error)
+	
+	CreateCompany(ctx context.Context, company *models.Company) error
+	GetAllCompanies(ctx context.Context) ([]models.Company, error)
+	GetCompanyByID(ctx context.Context, id uint) (*models.Company, error)
+	UpdateCompany(ctx context.Context, company *models.Company) error
+	DeleteCompany(ctx context.Context, id uint, cascade bool) error
+	
+	CreateFranchise(ctx context.Context, franchise *models.Franchise) error
+	GetAllFranchises(ctx context.Context) ([]models.Franchise, error)
+	GetFranchiseByID(ctx context.Context, id uint) (*models.Franchise, error)
+	UpdateFranchise(ctx context.Context, franchise *models.Franchise) error
+	DeleteFranchise(ctx context.Context, id uint) error
+	
+	AddGameRelation(ctx context.Context, relation *models.GameRelation) error
+	RemoveGameRelation(ctx context.Context, gameID, relationID uint) error
+	GetRelatedGames(ctx context.Context, game *models.Game) (*models.RelatedGames, error)
+	
+	LocalizeGames(ctx context.Context, games []models.Game, languages []string) error
+	LocalizeRelatedGames(ctx context.Context, related *models.RelatedGames, languages []string) error
+	GetGameTranslations(ctx context.Context, gameID uint) ([]models.GameTranslation, error)
+	SetGameTranslation(ctx context.Context, translation *models.GameTranslation) error
+	DeleteGameTranslation(ctx context.Context, gameID uint, locale string) error
+	
+	GetGameMedia(ctx context.Context, gameID uint) ([]models.GameMedia, error)
+	EmbedMedia(ctx context.Context, games []models.Game) error
+	AddGameMedia(ctx context.Context, media *models.GameMedia) error
+	ReorderGameMedia(ctx context.Context, gameID uint, mediaIDs []uint) ([]models.GameMedia, error)
+	RemoveGameMedia(ctx context.Context, gameID, mediaID uint) error
+	
+	SuggestTag(ctx context.Context, name string) (*models.Tag, bool, error)
+	ApproveTag(ctx context.Context, id uint) (*models.Tag, error)
+	RejectTag(ctx context.Context, id uint) (*models.Tag, error)
+	GetTags(ctx context.Context, status string) ([]models.Tag, error)
+	VoteGameTag(ctx context.Context, gameID uint, name, voter string) (*models.GameTag, error)
+	RemoveGameTagVote(ctx context.Context, gameID, tagID uint, voter string) error
+	
+	GetGameByExternalID(ctx context.Context, provider, externalID string) (*models.Game, error)
 }
@@ -2,26 +2,61 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+	"github.com/NNNACHID/api-game-catalog-cl/internal/pkg/mergepatch"
+	"github.com/NNNACHID/api-game-catalog-cl/internal/pkg/slug"
 	"github.com/NNNACHID/api-game-catalog-cl/internal/repository"
 )
 
+const (
+	defaultCurrency          = "EUR"
+	defaultAutocompleteLimit = 10
+	maxAutocompleteLimit     = 50
+)
+
 type gameService struct {
-	repo   repository.GameRepository
-	logger *logrus.Logger
+	repo          repository.GameRepository
+	logger        *logrus.Logger
+	defaultLocale string
 }
 
-func NewGameService(repo repository.GameRepository, logger *logrus.Logger) GameService {
+// NewGameService crée le service ; defaultLocale est la langue dans laquelle
+// sont rédigés les titres et descriptions des jeux (« fr » si vide ou invalide).
+func NewGameService(repo repository.GameRepository, logger *logrus.Logger, defaultLocale string) GameService {
+	locale, ok := normalizeLocale(defaultLocale)
+	if !ok {
+		locale = "fr"
+	}
+	
 	return &gameService{
-		repo:   repo,
-		logger: logger,
+		repo:          repo,
+		logger:        logger,
+		defaultLocale: locale,
 	}
 }
 
 func (s *gameService) CreateGame(ctx context.Context, game *models.Game) error {
+	applyDefaults(game)
+
+	err := s.validateGame(ctx, game)
+	if err != nil {
+		return err
+	}
+
+	game.UUID = ""
+	err = s.assignSlug(ctx, game)
+	if err != nil {
+		return err
+	}
+
 	s.logger.WithFields(logrus.Fields{
 		"title": game.Title,
 	}).Info("Création d'un nouveau jeu")
@@ -35,17 +70,79 @@ func (s *gameService) GetGameByID(ctx context.Context, id uint) (*models.Game, e
 }
 
 func (s *gameService) UpdateGame(ctx context.Context, game *models.Game) error {
-	game, err := s.repo.GetByID(ctx, game.ID)
+	existing, err := s.repo.GetByID(ctx, game.ID)
 	if err != nil {
 		return err
 	}
-	
+
+	applyDefaults(game)
+
+	err = s.validateGame(ctx, game)
+	if err != nil {
+		return err
+	}
+
+	game.CreatedAt = existing.CreatedAt
+	game.DeletedAt = existing.DeletedAt
+	game.UUID = existing.UUID
+	game.Slug = existing.Slug
+	if game.Slug == "" || slug.Make(game.Title) != slug.Make(existing.Title) {
+		err = s.assignSlug(ctx, game)
+		if err != nil {
+			return err
+		}
+	}
+
 	s.logger.WithFields(logrus.Fields{
 		"id":    game.ID,
 		"title": game.Title,
 	}).Info("Mise à jour d'un jeu")
-	
-	return s.repo.Update(ctx, game)
+
+	err = s.repo.Update(ctx, game)
+	if err != nil {
+		return err
+	}
+
+	updated, err := s.repo.GetByID(ctx, game.ID)
+	if err != nil {
+		return err
+	}
+
+	*game = *updated
+	return nil
+}
+
+func (s *gameService) PatchGame(ctx context.Context, id uint, patch []byte) (*models.Game, error) {
+	existing, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	original, err := json.Marshal(existing)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	merged, err := mergepatch.Apply(original, patch)
+	if err != nil {
+		return nil, apperrors.Wrap(apperrors.CodeValidation, err, "le patch JSON est invalide")
+	}
+
+	game := &models.Game{}
+	err = json.Unmarshal(merged, game)
+	if err != nil {
+		return nil, apperrors.Wrap(apperrors.CodeValidation, err, "le patch JSON produit un jeu invalide")
+	}
+
+	game.ID = id
+	s.logger.WithField("id", id).Info("Modification partielle d'un jeu")
+
+	err = s.UpdateGame(ctx, game)
+	if err != nil {
+		return nil, err
+	}
+
+	return game, nil
 }
 
 func (s *gameService) DeleteGame(ctx context.Context, id uint) error {
@@ -59,6 +156,17 @@ func (s *gameService) DeleteGame(ctx context.Context, id uint) error {
 	return s.repo.Delete(ctx, id)
 }
 
+func (s *gameService) RestoreGame(ctx context.Context, id uint) (*models.Game, error) {
+	s.logger.WithField("id", id).Info("Restauration d'un jeu supprimé")
+
+	err := s.repo.Restore(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, id)
+}
+
 func (s *gameService) ListGames(ctx context.Context, filter *models.GameFilter) (*models.GameResponse, error) {
 	if filter == nil {
 		filter = &models.GameFilter{
@@ -67,18 +175,147 @@ func (s *gameService) ListGames(ctx context.Context, filter *models.GameFilter)
 		}
 	}
 	
+	filter.RatingBoard = strings.ToUpper(filter.RatingBoard)
+	for i, tag := range filter.Tags {
+		filter.Tags[i] = normalizeTagName(tag)
+	}
+	filter.GameModes = normalizeValues(filter.GameModes)
+	filter.Accessibility = normalizeValues(filter.Accessibility)
+	filter.MissingExternalID = strings.ToLower(strings.TrimSpace(filter.MissingExternalID))
+	
+	fields := validateStruct(filter)
+	fields = append(fields, normalizeLanguageFilter("interface_languages", filter.InterfaceLanguages)...)
+	fields = append(fields, normalizeLanguageFilter("audio_languages", filter.AudioLanguages)...)
+	fields = append(fields, normalizeLanguageFilter("subtitle_languages", filter.SubtitleLanguages)...)
+	err := invalid(fields)
+	if err != nil {
+		return nil, err
+	}
+	
 	s.logger.WithFields(logrus.Fields{
 		"page":      filter.Page,
 		"page_size": filter.PageSize,
+		"q":         filter.Query,
 		"title":     filter.Title,
 	}).Info("Recherche de jeux")
 	
 	return s.repo.List(ctx, filter)
 }
 
+func (s *gameService) AutocompleteTitles(ctx context.Context, prefix string, limit int) ([]models.TitleSuggestion, error) {
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" {
+		return nil, apperrors.InvalidFields("le préfixe de recherche est obligatoire", apperrors.FieldError{
+			Field:   "prefix",
+			Message: "le préfixe de recherche est obligatoire",
+		})
+	}
+
+	if limit <= 0 {
+		limit = defaultAutocompleteLimit
+	}
+	if limit > maxAutocompleteLimit {
+		limit = maxAutocompleteLimit
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"prefix": prefix,
+		"limit":  limit,
+	}).Debug("Autocomplétion des titres")
+
+	return s.repo.AutocompleteTitles(ctx, prefix, limit)
+}
+
+func (s *gameService) AddGenreToGame(ctx context.Context, gameID, genreID uint) (*models.Game, error) {
+	_, err := s.repo.GetByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.repo.GetGenreByID(ctx, genreID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"id":       gameID,
+		"genre_id": genreID,
+	}).Info("Ajout d'un genre à un jeu")
+
+	err = s.repo.AddGenreToGame(ctx, gameID, genreID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, gameID)
+}
+
+func (s *gameService) RemoveGenreFromGame(ctx context.Context, gameID, genreID uint) (*models.Game, error) {
+	_, err := s.repo.GetByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"id":       gameID,
+		"genre_id": genreID,
+	}).Info("Retrait d'un genre d'un jeu")
+
+	err = s.repo.RemoveGenreFromGame(ctx, gameID, genreID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, gameID)
+}
+
+func (s *gameService) AddPlatformToGame(ctx context.Context, gameID, platformID uint) (*models.Game, error) {
+	_, err := s.repo.GetByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.repo.GetPlatformByID(ctx, platformID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"id":          gameID,
+		"platform_id": platformID,
+	}).Info("Ajout d'une plateforme à un jeu")
+
+	err = s.repo.AddPlatformToGame(ctx, gameID, platformID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, gameID)
+}
+
+func (s *gameService) RemovePlatformFromGame(ctx context.Context, gameID, platformID uint) (*models.Game, error) {
+	_, err := s.repo.GetByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"id":          gameID,
+		"platform_id": platformID,
+	}).Info("Retrait d'une plateforme d'un jeu")
+
+	err = s.repo.RemovePlatformFromGame(ctx, gameID, platformID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, gameID)
+}
+
 func (s *gameService) CreateGenre(ctx context.Context, genre *models.Genre) error {
-	if genre.Name == "" {
-		return errors.New("le nom du genre est obligatoire")
+	err := invalid(validateStruct(genre))
+	if err != nil {
+		return err
 	}
 	
 	s.logger.WithField("name", genre.Name).Info("Création d'un nouveau genre")
@@ -91,9 +328,64 @@ func (s *gameService) GetAllGenres(ctx context.Context) ([]models.Genre, error)
 	return s.repo.GetAllGenres(ctx)
 }
 
+func (s *gameService) GetGenreByID(ctx context.Context, id uint) (*models.Genre, error) {
+	s.logger.WithField("id", id).Info("Récupération d'un genre")
+	return s.repo.GetGenreByID(ctx, id)
+}
+
+func (s *gameService) UpdateGenre(ctx context.Context, genre *models.Genre) error {
+	err := invalid(validateStruct(genre))
+	if err != nil {
+		return err
+	}
+
+	existing, err := s.repo.GetGenreByID(ctx, genre.ID)
+	if err != nil {
+		return err
+	}
+	genre.CreatedAt = existing.CreatedAt
+
+	s.logger.WithFields(logrus.Fields{
+		"id":   genre.ID,
+		"name": genre.Name,
+	}).Info("Renommage d'un genre")
+
+	return s.repo.UpdateGenre(ctx, genre)
+}
+
+func (s *gameService) DeleteGenre(ctx context.Context, id uint, cascade bool) error {
+	s.logger.WithFields(logrus.Fields{
+		"id":      id,
+		"cascade": cascade,
+	}).Info("Suppression d'un genre")
+	return s.repo.DeleteGenre(ctx, id, cascade)
+}
+
+func (s *gameService) MergeGenres(ctx context.Context, sourceID, targetID uint) (*models.Genre, error) {
+	if sourceID == targetID {
+		return nil, apperrors.InvalidFields("un genre ne peut pas être fusionné avec lui-même", apperrors.FieldError{
+			Field:   "target_id",
+			Message: "le genre cible doit être différent du genre source",
+		})
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"source_id": sourceID,
+		"target_id": targetID,
+	}).Info("Fusion de deux genres")
+
+	err := s.repo.MergeGenres(ctx, sourceID, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetGenreByID(ctx, targetID)
+}
+
 func (s *gameService) CreatePlatform(ctx context.Context, platform *models.Platform) error {
-	if platform.Name == "" {
-		return errors.New("le nom de la plateforme est obligatoire")
+	err := invalid(validateStruct(platform))
+	if err != nil {
+		return err
 	}
 	
 	s.logger.WithField("name", platform.Name).Info("Création d'une nouvelle plateforme")
@@ -105,3 +397,612 @@ func (s *gameService) GetAllPlatforms(ctx context.Context) ([]models.Platform, e
 	s.logger.Info("Récupération de toutes les plateformes")
 	return s.repo.GetAllPlatforms(ctx)
 }
+
+func (s *gameService) GetPlatformByID(ctx context.Context, id uint) (*models.Platform, error) {
+	s.logger.WithField("id", id).Info("Récupération d'une plateforme")
+	return s.repo.GetPlatformByID(ctx, id)
+}
+
+func (s *gameService) UpdatePlatform(ctx context.Context, platform *models.Platform) error {
+	err := invalid(validateStruct(platform))
+	if err != nil {
+		return err
+	}
+
+	existing, err := s.repo.GetPlatformByID(ctx, platform.ID)
+	if err != nil {
+		return err
+	}
+	platform.CreatedAt = existing.CreatedAt
+
+	s.logger.WithFields(logrus.Fields{
+		"id":   platform.ID,
+		"name": platform.Name,
+	}).Info("Renommage d'une plateforme")
+
+	return s.repo.UpdatePlatform(ctx, platform)
+}
+
+func (s *gameService) DeletePlatform(ctx context.Context, id uint, cascade bool) error {
+	s.logger.WithFields(logrus.Fields{
+		"id":      id,
+		"cascade": cascade,
+	}).Info("Suppression d'une plateforme")
+	return s.repo.DeletePlatform(ctx, id, cascade)
+}
+
+func (s *gameService) MergePlatforms(ctx context.Context, sourceID, targetID uint) (*models.Platform, error) {
+	if sourceID == targetID {
+		return nil, apperrors.InvalidFields("une plateforme ne peut pas être fusionnée avec elle-même", apperrors.FieldError{
+			Field:   "target_id",
+			Message: "la plateforme cible doit être différente de la plateforme source",
+		})
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"source_id": sourceID,
+		"target_id": targetID,
+	}).Info("Fusion de deux plateformes")
+
+	err := s.repo.MergePlatforms(ctx, sourceID, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetPlatformByID(ctx, targetID)
+}
+
+func (s *gameService) CreateCompany(ctx context.Context, company *models.Company) error {
+	err := validateCompany(company)
+	if err != nil {
+		return err
+	}
+
+	s.logger.WithField("name", company.Name).Info("Création d'une nouvelle société")
+
+	return s.repo.CreateCompany(ctx, company)
+}
+
+func (s *gameService) GetAllCompanies(ctx context.Context) ([]models.Company, error) {
+	s.logger.Info("Récupération de toutes les sociétés")
+	return s.repo.GetAllCompanies(ctx)
+}
+
+func (s *gameService) GetCompanyByID(ctx context.Context, id uint) (*models.Company, error) {
+	s.logger.WithField("id", id).Info("Récupération d'une société")
+	return s.repo.GetCompanyByID(ctx, id)
+}
+
+func (s *gameService) UpdateCompany(ctx context.Context, company *models.Company) error {
+	err := validateCompany(company)
+	if err != nil {
+		return err
+	}
+
+	existing, err := s.repo.GetCompanyByID(ctx, company.ID)
+	if err != nil {
+		return err
+	}
+	company.CreatedAt = existing.CreatedAt
+
+	s.logger.WithFields(logrus.Fields{
+		"id":   company.ID,
+		"name": company.Name,
+	}).Info("Mise à jour d'une société")
+
+	return s.repo.UpdateCompany(ctx, company)
+}
+
+func (s *gameService) DeleteCompany(ctx context.Context, id uint, cascade bool) error {
+	s.logger.WithFields(logrus.Fields{
+		"id":      id,
+		"cascade": cascade,
+	}).Info("Suppression d'une société")
+	return s.repo.DeleteCompany(ctx, id, cascade)
+}
+
+func (s *gameService) CreateFranchise(ctx context.Context, franchise *models.Franchise) error {
+	err := invalid(validateStruct(franchise))
+	if err != nil {
+		return err
+	}
+
+	s.logger.WithField("name", franchise.Name).Info("Création d'une nouvelle franchise")
+
+	return s.repo.CreateFranchise(ctx, franchise)
+}
+
+func (s *gameService) GetAllFranchises(ctx context.Context) ([]models.Franchise, error) {
+	s.logger.Info("Récupération de toutes les franchises")
+	return s.repo.GetAllFranchises(ctx)
+}
+
+func (s *gameService) GetFranchiseByID(ctx context.Context, id uint) (*models.Franchise, error) {
+	s.logger.WithField("id", id).Info("Récupération d'une franchise")
+	return s.repo.GetFranchiseByID(ctx, id)
+}
+
+func (s *gameService) UpdateFranchise(ctx context.Context, franchise *models.Franchise) error {
+	err := invalid(validateStruct(franchise))
+	if err != nil {
+		return err
+	}
+
+	existing, err := s.repo.GetFranchiseByID(ctx, franchise.ID)
+	if err != nil {
+		return err
+	}
+	franchise.CreatedAt = existing.CreatedAt
+
+	s.logger.WithFields(logrus.Fields{
+		"id":   franchise.ID,
+		"name": franchise.Name,
+	}).Info("Mise à jour d'une franchise")
+
+	return s.repo.UpdateFranchise(ctx, franchise)
+}
+
+func (s *gameService) DeleteFranchise(ctx context.Context, id uint) error {
+	s.logger.WithField("id", id).Info("Suppression d'une franchise")
+	return s.repo.DeleteFranchise(ctx, id)
+}
+
+func (s *gameService) AddGameRelation(ctx context.Context, relation *models.GameRelation) error {
+	fields := validateStruct(relation)
+	if relation.RelatedGameID != 0 && relation.RelatedGameID == relation.GameID {
+		fields = append(fields, apperrors.FieldError{
+			Field:   "related_game_id",
+			Message: "un jeu ne peut pas être lié à lui-même",
+		})
+	}
+	err := invalid(fields)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.repo.GetByID(ctx, relation.GameID)
+	if err != nil {
+		return err
+	}
+
+	related, err := s.repo.GetByID(ctx, relation.RelatedGameID)
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return apperrors.InvalidFields(fmt.Sprintf("le jeu %d n'existe pas", relation.RelatedGameID), apperrors.FieldError{
+			Field:   "related_game_id",
+			Message: fmt.Sprintf("le jeu %d n'existe pas", relation.RelatedGameID),
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"id":              relation.GameID,
+		"related_game_id": relation.RelatedGameID,
+		"type":            relation.Type,
+	}).Info("Ajout d'une relation entre deux jeux")
+
+	err = s.repo.CreateRelation(ctx, relation)
+	if err != nil {
+		return err
+	}
+
+	relation.RelatedGame = related
+	return nil
+}
+
+func (s *gameService) RemoveGameRelation(ctx context.Context, gameID, relationID uint) error {
+	s.logger.WithFields(logrus.Fields{
+		"id":          gameID,
+		"relation_id": relationID,
+	}).Info("Suppression d'une relation entre deux jeux")
+	return s.repo.DeleteRelation(ctx, gameID, relationID)
+}
+
+func (s *gameService) GetRelatedGames(ctx context.Context, game *models.Game) (*models.RelatedGames, error) {
+	s.logger.WithField("id", game.ID).Debug("Récupération des jeux liés")
+	return s.repo.GetRelatedGames(ctx, game)
+}
+
+func applyDefaults(game *models.Game) {
+	if game.Currency == "" {
+		game.Currency = defaultCurrency
+	}
+	game.Currency = strings.ToUpper(game.Currency)
+
+	for i := range game.Prices {
+		game.Prices[i].Currency = strings.ToUpper(game.Prices[i].Currency)
+		game.Prices[i].Region = strings.ToUpper(game.Prices[i].Region)
+	}
+
+	for i := range game.Releases {
+		applyReleaseDefaults(&game.Releases[i])
+	}
+	for i := range game.AgeRatings {
+		applyAgeRatingDefaults(&game.AgeRatings[i])
+	}
+	for i := range game.SystemRequirements {
+		applyRequirementsDefaults(&game.SystemRequirements[i])
+	}
+	game.GameModes = normalizeValues(game.GameModes)
+	game.Accessibility = normalizeValues(game.Accessibility)
+	for i := range game.ExternalIDs {
+		normalizeExternalID(&game.ExternalIDs[i])
+	}
+	if game.ReleaseDate.IsZero() {
+		game.ReleaseDate = earliestReleaseDate(game.Releases)
+	}
+}
+
+// validatePriceScopes vérifie qu'un jeu n'a qu'un prix par plateforme (ou pour
+// toutes) et par région.
+func validatePriceScopes(prices []models.GamePrice) []apperrors.FieldError {
+	var fields []apperrors.FieldError
+	seen := make(map[string]bool, len(prices))
+
+	for i, price := range prices {
+		platform, duplicate := scopeSeen(seen, price.PlatformID, price.Region)
+		if duplicate {
+			fields = append(fields, apperrors.FieldError{
+				Field:   fmt.Sprintf("prices[%d]", i),
+				Message: fmt.Sprintf("le jeu a déjà un prix pour %s dans cette région", platform),
+			})
+		}
+	}
+
+	return fields
+}
+
+// validateReleaseScopes vérifie qu'un jeu n'a qu'une sortie par plateforme (ou
+// pour toutes) et par région.
+func validateReleaseScopes(releases []models.GameRelease) []apperrors.FieldError {
+	var fields []apperrors.FieldError
+	seen := make(map[string]bool, len(releases))
+
+	for i, release := range releases {
+		platform, duplicate := scopeSeen(seen, release.PlatformID, release.Region)
+		if duplicate {
+			fields = append(fields, apperrors.FieldError{
+				Field:   fmt.Sprintf("releases[%d]", i),
+				Message: fmt.Sprintf("le jeu a déjà une sortie pour %s dans cette région", platform),
+			})
+		}
+	}
+
+	return fields
+}
+
+// scopeSeen décrit la plateforme d'un périmètre (plateforme et région) et
+// indique si ce périmètre a déjà été rencontré.
+func scopeSeen(seen map[string]bool, platformID *uint, region string) (string, bool) {
+	platform := "toutes les plateformes"
+	if platformID != nil {
+		platform = fmt.Sprintf("la plateforme %d", *platformID)
+	}
+
+	key := platform + "/" + region
+	duplicate := seen[key]
+	seen[key] = true
+	return platform, duplicate
+}
+
+// applyReleaseDefaults ramène la date au début de la période indiquée par sa
+// précision et déduit le statut lorsqu'il n'est pas fourni.
+func applyReleaseDefaults(release *models.GameRelease) {
+	release.Region = strings.ToUpper(release.Region)
+	if release.Precision == "" {
+		release.Precision = models.PrecisionDay
+	}
+
+	if release.Date != nil {
+		date := release.Date.UTC()
+		year, month, day := date.Date()
+		switch release.Precision {
+		case models.PrecisionMonth:
+			day = 1
+		case models.PrecisionQuarter:
+			month, day = month-(month-1)%3, 1
+		case models.PrecisionYear:
+			month, day = time.January, 1
+		}
+		start := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		release.Date = &start
+	}
+
+	if release.Status == "" {
+		release.Status = models.ReleaseAnnounced
+		if release.Date != nil && release.Precision == models.PrecisionDay && !release.Date.After(time.Now()) {
+			release.Status = models.ReleaseReleased
+		}
+	}
+}
+
+// earliestReleaseDate retourne la première date de sortie connue, hors sorties
+// annulées, pour renseigner la date de sortie principale du jeu.
+func earliestReleaseDate(releases []models.GameRelease) time.Time {
+	var earliest time.Time
+	for _, release := range releases {
+		if release.Date == nil || release.Status == models.ReleaseCancelled {
+			continue
+		}
+		if earliest.IsZero() || release.Date.Before(earliest) {
+			earliest = *release.Date
+		}
+	}
+
+	return earliest
+}
+
+// validateGame vérifie les règles déclaratives du modèle ainsi que l'existence
+// des genres et plateformes référencés, et retourne toutes les violations.
+func (s *gameService) validateGame(ctx context.Context, game *models.Game) error {
+	fields := validateStruct(game)
+	fields = append(fields, validateAgeRatings(game.AgeRatings)...)
+	fields = append(fields, validateSystemRequirements(game.SystemRequirements)...)
+	fields = append(fields, validatePriceScopes(game.Prices)...)
+	fields = append(fields, validateReleaseScopes(game.Releases)...)
+	fields = append(fields, validateAttributes(game)...)
+
+	violations, err := s.validateExternalIDs(ctx, game)
+	if err != nil {
+		return err
+	}
+	fields = append(fields, violations...)
+
+	genreField := "genres"
+	if game.GenreIDs != nil {
+		genreField = "genre_ids"
+		game.Genres = make([]models.Genre, 0, len(game.GenreIDs))
+		for _, id := range game.GenreIDs {
+			game.Genres = append(game.Genres, models.Genre{ID: id})
+		}
+	}
+
+	genreIDs := make([]uint, 0, len(game.Genres))
+	for _, genre := range game.Genres {
+		genreIDs = append(genreIDs, genre.ID)
+	}
+	if len(genreIDs) > 0 {
+		genres, err := s.repo.FindGenresByIDs(ctx, genreIDs)
+		if err != nil {
+			return err
+		}
+
+		found := make(map[uint]models.Genre, len(genres))
+		for _, genre := range genres {
+			found[genre.ID] = genre
+		}
+
+		resolved := make([]models.Genre, 0, len(genreIDs))
+		seen := make(map[uint]bool, len(genreIDs))
+		for i, id := range genreIDs {
+			genre, ok := found[id]
+			if !ok {
+				fields = append(fields, apperrors.FieldError{
+					Field:   fmt.Sprintf("%s[%d]", genreField, i),
+					Message: fmt.Sprintf("le genre %d n'existe pas", id),
+				})
+				continue
+			}
+			if !seen[id] {
+				seen[id] = true
+				resolved = append(resolved, genre)
+			}
+		}
+		game.Genres = resolved
+	}
+
+	platformField := "platforms"
+	if game.PlatformIDs != nil {
+		platformField = "platform_ids"
+		game.Platforms = make([]models.Platform, 0, len(game.PlatformIDs))
+		for _, id := range game.PlatformIDs {
+			game.Platforms = append(game.Platforms, models.Platform{ID: id})
+		}
+	}
+
+	platformIDs := make([]uint, 0, len(game.Platforms)+len(game.Prices))
+	for _, platform := range game.Platforms {
+		platformIDs = append(platformIDs, platform.ID)
+	}
+	for _, price := range game.Prices {
+		if price.PlatformID != nil {
+			platformIDs = append(platformIDs, *price.PlatformID)
+		}
+	}
+	for _, release := range game.Releases {
+		if release.PlatformID != nil {
+			platformIDs = append(platformIDs, *release.PlatformID)
+		}
+	}
+	for _, requirements := range game.SystemRequirements {
+		if requirements.PlatformID != 0 {
+			platformIDs = append(platformIDs, requirements.PlatformID)
+		}
+	}
+	if len(platformIDs) > 0 {
+		platforms, err := s.repo.FindPlatformsByIDs(ctx, platformIDs)
+		if err != nil {
+			return err
+		}
+
+		found := make(map[uint]models.Platform, len(platforms))
+		for _, platform := range platforms {
+			found[platform.ID] = platform
+		}
+
+		resolved := make([]models.Platform, 0, len(game.Platforms))
+		seen := make(map[uint]bool, len(game.Platforms))
+		for i, platform := range game.Platforms {
+			existing, ok := found[platform.ID]
+			if !ok {
+				fields = append(fields, apperrors.FieldError{
+					Field:   fmt.Sprintf("%s[%d]", platformField, i),
+					Message: fmt.Sprintf("la plateforme %d n'existe pas", platform.ID),
+				})
+				continue
+			}
+			if !seen[platform.ID] {
+				seen[platform.ID] = true
+				resolved = append(resolved, existing)
+			}
+		}
+		game.Platforms = resolved
+
+		for i, price := range game.Prices {
+			if price.PlatformID == nil {
+				continue
+			}
+			if _, ok := found[*price.PlatformID]; !ok {
+				fields = append(fields, apperrors.FieldError{
+					Field:   fmt.Sprintf("prices[%d].platform_id", i),
+					Message: fmt.Sprintf("la plateforme %d n'existe pas", *price.PlatformID),
+				})
+			}
+		}
+
+		for i, release := range game.Releases {
+			if release.PlatformID == nil {
+				continue
+			}
+			if _, ok := found[*release.PlatformID]; !ok {
+				fields = append(fields, apperrors.FieldError{
+					Field:   fmt.Sprintf("releases[%d].platform_id", i),
+					Message: fmt.Sprintf("la plateforme %d n'existe pas", *release.PlatformID),
+				})
+			}
+		}
+
+		for i, requirements := range game.SystemRequirements {
+			if requirements.PlatformID == 0 {
+				continue
+			}
+			if _, ok := found[requirements.PlatformID]; !ok {
+				fields = append(fields, apperrors.FieldError{
+					Field:   fmt.Sprintf("system_requirements[%d].platform_id", i),
+					Message: fmt.Sprintf("la plateforme %d n'existe pas", requirements.PlatformID),
+				})
+			}
+		}
+	}
+
+	developerField, developerIDs := "developers", companyIDs(game.Developers)
+	if game.DeveloperIDs != nil {
+		developerField, developerIDs = "developer_ids", game.DeveloperIDs
+	}
+	developers, violations, err := s.resolveCompanies(ctx, developerField, developerIDs)
+	if err != nil {
+		return err
+	}
+	fields = append(fields, violations...)
+	game.Developers = developers
+
+	publisherField, publisherIDs := "publishers", companyIDs(game.Publishers)
+	if game.PublisherIDs != nil {
+		publisherField, publisherIDs = "publisher_ids", game.PublisherIDs
+	}
+	publishers, violations, err := s.resolveCompanies(ctx, publisherField, publisherIDs)
+	if err != nil {
+		return err
+	}
+	fields = append(fields, violations...)
+	game.Publishers = publishers
+
+	if game.Developer == "" {
+		game.Developer = companyNames(game.Developers)
+	}
+	if game.Publisher == "" {
+		game.Publisher = companyNames(game.Publishers)
+	}
+
+	if game.FranchiseID != nil {
+		_, err := s.repo.GetFranchiseByID(ctx, *game.FranchiseID)
+		if errors.Is(err, apperrors.ErrNotFound) {
+			fields = append(fields, apperrors.FieldError{
+				Field:   "franchise_id",
+				Message: fmt.Sprintf("la franchise %d n'existe pas", *game.FranchiseID),
+			})
+		} else if err != nil {
+			return err
+		}
+	}
+	game.Franchise = nil
+
+	game.GenreIDs = nil
+	game.PlatformIDs = nil
+	game.DeveloperIDs = nil
+	game.PublisherIDs = nil
+
+	return invalid(fields)
+}
+
+// resolveCompanies charge les sociétés désignées, sans doublon, et signale
+// chaque identifiant inconnu sous le champ donné.
+func (s *gameService) resolveCompanies(ctx context.Context, field string, ids []uint) ([]models.Company, []apperrors.FieldError, error) {
+	if len(ids) == 0 {
+		return nil, nil, nil
+	}
+
+	companies, err := s.repo.FindCompaniesByIDs(ctx, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	found := make(map[uint]models.Company, len(companies))
+	for _, company := range companies {
+		found[company.ID] = company
+	}
+
+	var fields []apperrors.FieldError
+	resolved := make([]models.Company, 0, len(ids))
+	seen := make(map[uint]bool, len(ids))
+	for i, id := range ids {
+		company, ok := found[id]
+		if !ok {
+			fields = append(fields, apperrors.FieldError{
+				Field:   fmt.Sprintf("%s[%d]", field, i),
+				Message: fmt.Sprintf("la société %d n'existe pas", id),
+			})
+			continue
+		}
+		if !seen[id] {
+			seen[id] = true
+			resolved = append(resolved, company)
+		}
+	}
+
+	return resolved, fields, nil
+}
+
+func companyIDs(companies []models.Company) []uint {
+	ids := make([]uint, 0, len(companies))
+	for _, company := range companies {
+		ids = append(ids, company.ID)
+	}
+
+	return ids
+}
+
+func companyNames(companies []models.Company) string {
+	names := make([]string, 0, len(companies))
+	for _, company := range companies {
+		names = append(names, company.Name)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// validateCompany vérifie la société et calcule sa clé de dédoublonnage.
+func validateCompany(company *models.Company) error {
+	fields := validateStruct(company)
+
+	company.Key = models.CompanyKey(company.Name)
+	if company.Name != "" && company.Key == "" {
+		fields = append(fields, apperrors.FieldError{
+			Field:   "name",
+			Message: "le nom de la société doit contenir au moins une lettre ou un chiffre",
+		})
+	}
+
+	return invalid(fields)
+}
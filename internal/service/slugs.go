@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+	"github.com/NNNACHID/api-game-catalog-cl/internal/pkg/slug"
+	"github.com/sirupsen/logrus"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// assignSlug dérive le slug du jeu de son titre, suffixé par -2, -3... s'il
+// est déjà pris par un autre jeu. Un ancien slug du jeu lui-même est repris.
+func (s *gameService) assignSlug(ctx context.Context, game *models.Game) error {
+	base := slug.Make(game.Title)
+	existing, err := s.repo.FindSlugs(ctx, base)
+	if err != nil {
+		return err
+	}
+
+	owners := make(map[string]uint, len(existing))
+	for _, taken := range existing {
+		owners[taken.Slug] = taken.GameID
+	}
+
+	candidate := base
+	for n := 2; ; n++ {
+		owner, ok := owners[candidate]
+		if !ok || owner == game.ID {
+			break
+		}
+		candidate = fmt.Sprintf("%s-%d", base, n)
+	}
+
+	game.Slug = candidate
+	return nil
+}
+
+// ResolveGameRef convertit la référence d'un jeu (identifiant numérique, UUID,
+// slug courant ou ancien) en identifiant, sans charger le jeu. Un identifiant
+// numérique est retourné tel quel ; son existence est vérifiée par l'opération
+// qui suit.
+func (s *gameService) ResolveGameRef(ctx context.Context, ref string) (uint, error) {
+	ref = strings.TrimSpace(ref)
+
+	id, err := strconv.ParseUint(ref, 10, 32)
+	if err == nil {
+		return uint(id), nil
+	}
+
+	if uuidPattern.MatchString(ref) {
+		return s.repo.FindGameIDByUUID(ctx, strings.ToLower(ref))
+	}
+
+	ref = strings.ToLower(ref)
+	gameID, err := s.repo.FindGameIDBySlug(ctx, ref)
+	if !errors.Is(err, apperrors.ErrNotFound) {
+		return gameID, err
+	}
+
+	redirect, err := s.repo.FindSlugRedirect(ctx, ref)
+	if err != nil {
+		return 0, err
+	}
+	return redirect.GameID, nil
+}
+
+// GetGameByRef charge un jeu désigné par son identifiant numérique, son UUID
+// ou son slug. Pour un ancien slug, le jeu est retourné avec moved à true afin
+// que l'appelant redirige vers le slug courant.
+func (s *gameService) GetGameByRef(ctx context.Context, ref string) (*models.Game, bool, error) {
+	ref = strings.TrimSpace(ref)
+
+	id, err := strconv.ParseUint(ref, 10, 32)
+	if err == nil {
+		game, err := s.GetGameByID(ctx, uint(id))
+		return game, false, err
+	}
+
+	s.logger.WithField("ref", ref).Info("Récupération d'un jeu")
+
+	if uuidPattern.MatchString(ref) {
+		game, err := s.repo.GetGameByUUID(ctx, strings.ToLower(ref))
+		return game, false, err
+	}
+
+	ref = strings.ToLower(ref)
+	game, err := s.repo.GetGameBySlug(ctx, ref)
+	if !errors.Is(err, apperrors.ErrNotFound) {
+		return game, false, err
+	}
+
+	redirect, err := s.repo.FindSlugRedirect(ctx, ref)
+	if err != nil {
+		return nil, false, err
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"slug":    ref,
+		"game_id": redirect.GameID,
+	}).Info("Ancien slug, redirection vers le slug courant")
+
+	game, err = s.repo.GetByID(ctx, redirect.GameID)
+	if err != nil {
+		return nil, false, err
+	}
+	return game, true, nil
+}
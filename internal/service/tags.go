@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// normalizeTagName met un nom de tag en minuscules et réduit ses espaces, afin
+// que « Co-op » et « co-op » désignent le même tag.
+func normalizeTagName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// SuggestTag propose un tag, soumis à modération. Si un tag du même nom existe
+// déjà, il est retourné tel quel et created vaut false.
+func (s *gameService) SuggestTag(ctx context.Context, name string) (*models.Tag, bool, error) {
+	tag := &models.Tag{Name: normalizeTagName(name), Status: models.TagPending}
+	err := invalid(validateStruct(tag))
+	if err != nil {
+		return nil, false, err
+	}
+
+	existing, err := s.repo.GetTagByName(ctx, tag.Name)
+	if err == nil {
+		return existing, false, nil
+	}
+	if !errors.Is(err, apperrors.ErrNotFound) {
+		return nil, false, err
+	}
+
+	s.logger.WithField("name", tag.Name).Info("Proposition d'un nouveau tag")
+	err = s.repo.CreateTag(ctx, tag)
+	if errors.Is(err, apperrors.ErrConflict) {
+		existing, err = s.repo.GetTagByName(ctx, tag.Name)
+		return existing, false, err
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return tag, true, nil
+}
+
+func (s *gameService) ApproveTag(ctx context.Context, id uint) (*models.Tag, error) {
+	return s.moderateTag(ctx, id, models.TagApproved)
+}
+
+func (s *gameService) RejectTag(ctx context.Context, id uint) (*models.Tag, error) {
+	return s.moderateTag(ctx, id, models.TagRejected)
+}
+
+func (s *gameService) moderateTag(ctx context.Context, id uint, status string) (*models.Tag, error) {
+	s.logger.WithFields(logrus.Fields{
+		"id":     id,
+		"status": status,
+	}).Info("Modération d'un tag")
+
+	err := s.repo.UpdateTagStatus(ctx, id, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetTagByID(ctx, id)
+}
+
+// GetTags liste les tags d'un statut (approuvés par défaut), du plus populaire
+// au moins populaire.
+func (s *gameService) GetTags(ctx context.Context, status string) ([]models.Tag, error) {
+	if status == "" {
+		status = models.TagApproved
+	}
+
+	switch status {
+	case models.TagPending, models.TagApproved, models.TagRejected:
+	default:
+		return nil, apperrors.InvalidFields("statut de tag invalide", apperrors.FieldError{
+			Field:   "status",
+			Message: "le statut doit valoir l'une des valeurs suivantes : pending, approved, rejected",
+		})
+	}
+
+	return s.repo.GetTags(ctx, status)
+}
+
+// VoteGameTag ajoute le vote d'un votant pour un tag sur un jeu ; chaque
+// votant ne compte qu'une fois. Un tag encore inconnu est proposé à la
+// modération et n'apparaît sur le jeu qu'une fois approuvé ; un tag refusé ne
+// peut pas recevoir de vote.
+func (s *gameService) VoteGameTag(ctx context.Context, gameID uint, name, voter string) (*models.GameTag, error) {
+	voterKey, err := tagVoterKey(voter)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.repo.GetByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, _, err := s.SuggestTag(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if tag.Status == models.TagRejected {
+		return nil, apperrors.InvalidFields(fmt.Sprintf("le tag %s a été refusé par la modération", tag.Name), apperrors.FieldError{
+			Field:   "name",
+			Message: fmt.Sprintf("le tag %s a été refusé par la modération", tag.Name),
+		})
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"id":     gameID,
+		"tag_id": tag.ID,
+	}).Info("Vote pour un tag de jeu")
+
+	gameTag, err := s.repo.VoteGameTag(ctx, gameID, tag.ID, voterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	gameTag.Tag = tag
+	return gameTag, nil
+}
+
+// RemoveGameTagVote retire le vote d'un votant ; il ne peut retirer que le
+// sien.
+func (s *gameService) RemoveGameTagVote(ctx context.Context, gameID, tagID uint, voter string) error {
+	voterKey, err := tagVoterKey(voter)
+	if err != nil {
+		return err
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"id":     gameID,
+		"tag_id": tagID,
+	}).Info("Retrait d'un vote pour un tag de jeu")
+	return s.repo.UnvoteGameTag(ctx, gameID, tagID, voterKey)
+}
+
+// tagVoterKey retourne l'empreinte SHA-256 du votant (identifiant fourni par
+// le client ou adresse IP), pour ne stocker ni l'un ni l'autre en clair.
+func tagVoterKey(voter string) (string, error) {
+	voter = strings.TrimSpace(voter)
+	if voter == "" {
+		return "", apperrors.InvalidFields("le votant est obligatoire", apperrors.FieldError{
+			Field:   "voter_id",
+			Message: "le votant est obligatoire",
+		})
+	}
+
+	sum := sha256.Sum256([]byte(voter))
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/text/language"
+)
+
+// normalizeLocale renvoie la forme canonique BCP 47 d'une langue
+// (« fr-ca » devient « fr-CA »).
+func normalizeLocale(locale string) (string, bool) {
+	tag, err := language.Parse(strings.TrimSpace(locale))
+	if err != nil || tag == language.Und {
+		return "", false
+	}
+	return tag.String(), true
+}
+
+// localeChain construit la chaîne de repli à partir des langues demandées, par
+// ordre de préférence : chaque langue suivie de sa langue de base (fr-CA puis
+// fr), jusqu'à la langue par défaut du catalogue, qui la termine toujours. Les
+// langues invalides sont ignorées.
+func (s *gameService) localeChain(languages []string) []string {
+	var chain []string
+	seen := make(map[string]bool)
+	add := func(locale string) {
+		if !seen[locale] {
+			seen[locale] = true
+			chain = append(chain, locale)
+		}
+	}
+
+	for _, raw := range languages {
+		tag, err := language.Parse(strings.TrimSpace(raw))
+		if err != nil || tag == language.Und {
+			continue
+		}
+
+		add(tag.String())
+		base, _ := tag.Base()
+		add(base.String())
+		if seen[s.defaultLocale] {
+			break
+		}
+	}
+
+	add(s.defaultLocale)
+	for i, locale := range chain {
+		if locale == s.defaultLocale {
+			return chain[:i+1]
+		}
+	}
+	return chain
+}
+
+// LocalizeGames remplace le titre et la description des jeux par leur
+// meilleure traduction selon les langues demandées. Chaque champ suit
+// indépendamment la chaîne de repli et retombe en dernier lieu sur le texte
+// d'origine, rédigé dans la langue par défaut du catalogue.
+func (s *gameService) LocalizeGames(ctx context.Context, games []models.Game, languages []string) error {
+	if len(games) == 0 || len(languages) == 0 {
+		return nil
+	}
+
+	chain := s.localeChain(languages)
+	byGame := make(map[uint]map[string]models.GameTranslation)
+	if len(chain) > 1 {
+		ids := make([]uint, 0, len(games))
+		for _, game := range games {
+			ids = append(ids, game.ID)
+		}
+
+		translations, err := s.repo.FindGameTranslations(ctx, ids, chain[:len(chain)-1])
+		if err != nil {
+			s.logger.WithError(err).Error("Erreur lors de la récupération des traductions")
+			return err
+		}
+
+		for _, translation := range translations {
+			if byGame[translation.GameID] == nil {
+				byGame[translation.GameID] = make(map[string]models.GameTranslation)
+			}
+			byGame[translation.GameID][translation.Locale] = translation
+		}
+	}
+
+	for i := range games {
+		localizeGame(&games[i], chain, byGame[games[i].ID])
+	}
+	return nil
+}
+
+// LocalizeRelatedGames localise tous les jeux liés : la série, et les jeux
+// des relations dans les deux sens (DLC, remasters, jeu de base...), en une
+// seule recherche de traductions.
+func (s *gameService) LocalizeRelatedGames(ctx context.Context, related *models.RelatedGames, languages []string) error {
+	if related == nil {
+		return nil
+	}
+
+	var embedded []*models.Game
+	for i := range related.Series {
+		embedded = append(embedded, &related.Series[i])
+	}
+	for _, relation := range related.Relations {
+		if relation.RelatedGame != nil {
+			embedded = append(embedded, relation.RelatedGame)
+		}
+	}
+	for _, relation := range related.InverseRelations {
+		if relation.Game != nil {
+			embedded = append(embedded, relation.Game)
+		}
+	}
+
+	games := make([]models.Game, len(embedded))
+	for i, game := range embedded {
+		games[i] = *game
+	}
+
+	err := s.LocalizeGames(ctx, games, languages)
+	if err != nil {
+		return err
+	}
+
+	for i, game := range embedded {
+		*game = games[i]
+	}
+	return nil
+}
+
+func localizeGame(game *models.Game, chain []string, translations map[string]models.GameTranslation) {
+	var title, description string
+	game.Locale = ""
+
+	for _, locale := range chain {
+		translation, ok := translations[locale]
+		if !ok {
+			continue
+		}
+		if game.Locale == "" {
+			game.Locale = locale
+		}
+		if title == "" {
+			title = translation.Title
+		}
+		if description == "" {
+			description = translation.Description
+		}
+	}
+
+	if game.Locale == "" {
+		game.Locale = chain[len(chain)-1]
+	}
+	if title != "" {
+		game.Title = title
+	}
+	if description != "" {
+		game.Description = description
+	}
+}
+
+func (s *gameService) GetGameTranslations(ctx context.Context, gameID uint) ([]models.GameTranslation, error) {
+	_, err := s.repo.GetByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetGameTranslations(ctx, gameID)
+}
+
+func (s *gameService) SetGameTranslation(ctx context.Context, translation *models.GameTranslation) error {
+	translation.Title = strings.TrimSpace(translation.Title)
+	translation.Description = strings.TrimSpace(translation.Description)
+
+	fields := validateStruct(translation)
+	if translation.Locale != "" {
+		locale, ok := normalizeLocale(translation.Locale)
+		switch {
+		case !ok:
+			fields = append(fields, apperrors.FieldError{
+				Field:   "locale",
+				Message: fmt.Sprintf("%s n'est pas une langue valide (BCP 47, par exemple fr ou en-US)", translation.Locale),
+			})
+		case locale == s.defaultLocale:
+			fields = append(fields, apperrors.FieldError{
+				Field:   "locale",
+				Message: fmt.Sprintf("%s est la langue par défaut du catalogue : modifiez directement le jeu", locale),
+			})
+		}
+		translation.Locale = locale
+	}
+	if translation.Title == "" && translation.Description == "" {
+		fields = append(fields, apperrors.FieldError{
+			Field:   "title",
+			Message: "la traduction doit contenir un titre ou une description",
+		})
+	}
+	err := invalid(fields)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.repo.GetByID(ctx, translation.GameID)
+	if err != nil {
+		return err
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"id":     translation.GameID,
+		"locale": translation.Locale,
+	}).Info("Enregistrement d'une traduction de jeu")
+	return s.repo.SaveGameTranslation(ctx, translation)
+}
+
+func (s *gameService) DeleteGameTranslation(ctx context.Context, gameID uint, locale string) error {
+	normalized, ok := normalizeLocale(locale)
+	if !ok {
+		return apperrors.NotFound("translation not found")
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"id":     gameID,
+		"locale": normalized,
+	}).Info("Suppression d'une traduction de jeu")
+	return s.repo.DeleteGameTranslation(ctx, gameID, normalized)
+}
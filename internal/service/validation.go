@@ -0,0 +1,167 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	earliestReleaseYear = 1950
+	releaseDateHorizon  = 10
+)
+
+var validate = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == "" {
+			name = field.Tag.Get("form")
+		}
+		if name == "" || name == "-" {
+			return field.Name
+		}
+		return name
+	})
+
+	v.RegisterCustomTypeFunc(func(field reflect.Value) interface{} {
+		amount, ok := field.Interface().(decimal.Decimal)
+		if !ok {
+			return nil
+		}
+		value, _ := amount.Float64()
+		return value
+	}, decimal.Decimal{})
+
+	_ = v.RegisterValidation("release_date", func(fl validator.FieldLevel) bool {
+		date, ok := fl.Field().Interface().(time.Time)
+		if !ok {
+			return false
+		}
+		if date.IsZero() {
+			return true
+		}
+
+		return date.Year() >= earliestReleaseYear && date.Before(time.Now().AddDate(releaseDateHorizon, 0, 0))
+	})
+
+	return v
+}
+
+// validateStruct applique les règles déclarées dans les tags `validate` des
+// modèles et retourne l'ensemble des violations.
+func validateStruct(value interface{}) []apperrors.FieldError {
+	err := validate.Struct(value)
+	if err == nil {
+		return nil
+	}
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return []apperrors.FieldError{{Message: err.Error()}}
+	}
+
+	structType := reflect.Indirect(reflect.ValueOf(value)).Type()
+	fields := make([]apperrors.FieldError, 0, len(validationErrors))
+	for _, fieldErr := range validationErrors {
+		fields = append(fields, apperrors.FieldError{
+			Field:   fieldPath(fieldErr),
+			Message: violationMessage(structType, fieldErr),
+		})
+	}
+
+	return fields
+}
+
+// fieldPath retourne le chemin JSON du champ en violation, sans le nom de la
+// structure racine (par exemple "prices[0].amount").
+func fieldPath(fieldErr validator.FieldError) string {
+	namespace := fieldErr.Namespace()
+	if index := strings.Index(namespace, "."); index >= 0 {
+		return namespace[index+1:]
+	}
+
+	return fieldErr.Field()
+}
+
+func fieldLabel(structType reflect.Type, fieldErr validator.FieldError) string {
+	segments := strings.Split(fieldErr.StructNamespace(), ".")[1:]
+
+	currentType := structType
+	for i, segment := range segments {
+		if index := strings.Index(segment, "["); index >= 0 {
+			segment = segment[:index]
+		}
+
+		field, ok := currentType.FieldByName(segment)
+		if !ok {
+			break
+		}
+		if i == len(segments)-1 {
+			if label := field.Tag.Get("label"); label != "" {
+				return label
+			}
+			break
+		}
+
+		currentType = field.Type
+		for currentType.Kind() == reflect.Slice || currentType.Kind() == reflect.Ptr {
+			currentType = currentType.Elem()
+		}
+	}
+
+	return "le champ " + fieldErr.Field()
+}
+
+func violationMessage(structType reflect.Type, fieldErr validator.FieldError) string {
+	label := fieldLabel(structType, fieldErr)
+
+	switch fieldErr.Tag() {
+	case "required":
+		return label + " est obligatoire"
+	case "max":
+		if fieldErr.Kind() == reflect.String {
+			return fmt.Sprintf("%s ne doit pas dépasser %s caractères", label, fieldErr.Param())
+		}
+		return fmt.Sprintf("%s doit être inférieur ou égal à %s", label, fieldErr.Param())
+	case "lte":
+		return fmt.Sprintf("%s doit être inférieur ou égal à %s", label, fieldErr.Param())
+	case "gte":
+		return fmt.Sprintf("%s doit être supérieur ou égal à %s", label, fieldErr.Param())
+	case "oneof":
+		values := strings.Fields(fieldErr.Param())
+		if len(values) == 2 {
+			return fmt.Sprintf("%s doit valoir %s ou %s", label, values[0], values[1])
+		}
+		return fmt.Sprintf("%s doit valoir l'une des valeurs suivantes : %s", label, strings.Join(values, ", "))
+	case "url":
+		return label + " doit être une URL valide"
+	case "iso4217":
+		return label + " doit être un code devise ISO 4217"
+	case "release_date":
+		return fmt.Sprintf("%s doit être comprise entre %d et les %d prochaines années", label, earliestReleaseYear, releaseDateHorizon)
+	default:
+		return fmt.Sprintf("%s ne respecte pas la règle '%s'", label, fieldErr.Tag())
+	}
+}
+
+// invalid regroupe les violations dans une seule erreur de validation.
+func invalid(fields []apperrors.FieldError) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	if len(fields) == 1 {
+		return apperrors.InvalidFields(fields[0].Message, fields...)
+	}
+
+	return apperrors.InvalidFields(fmt.Sprintf("%d champs invalides", len(fields)), fields...)
+}
@@ -36,7 +36,8 @@ func NewPostgresConnection(config PostgresConfig, log *logrus.Logger) (*gorm.DB,
 	)
 
 	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
-		Logger: gormLogger,
+		Logger:         gormLogger,
+		TranslateError: true,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("erreur de connexion à PostgreSQL: %w", err)
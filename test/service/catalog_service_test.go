@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/NNNACHID/api-game-catalog-cl/internal/models"
+	apperrors "github.com/NNNACHID/api-game-catalog-cl/internal/pkg/errors"
 	"github.com/NNNACHID/api-game-catalog-cl/internal/service"
 	//"github.com/NNNACHID/api-game-catalog-cl/internal/catalog/repository"
 )
@@ -41,6 +44,11 @@ func (m *MockGameRepository) Delete(ctx context.Context, id uint) error {
 	return args.Error(0)
 }
 
+func (m *MockGameRepository) Restore(ctx context.Context, id uint) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
 func (m *MockGameRepository) List(ctx context.Context, filter *models.GameFilter) (*models.GameResponse, error) {
 	args := m.Called(ctx, filter)
 	if args.Get(0) == nil {
@@ -49,6 +57,31 @@ func (m *MockGameRepository) List(ctx context.Context, filter *models.GameFilter
 	return args.Get(0).(*models.GameResponse), args.Error(1)
 }
 
+func (m *MockGameRepository) AutocompleteTitles(ctx context.Context, prefix string, limit int) ([]models.TitleSuggestion, error) {
+	args := m.Called(ctx, prefix, limit)
+	return args.Get(0).([]models.TitleSuggestion), args.Error(1)
+}
+
+func (m *MockGameRepository) AddGenreToGame(ctx context.Context, gameID, genreID uint) error {
+	args := m.Called(ctx, gameID, genreID)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) RemoveGenreFromGame(ctx context.Context, gameID, genreID uint) error {
+	args := m.Called(ctx, gameID, genreID)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) AddPlatformToGame(ctx context.Context, gameID, platformID uint) error {
+	args := m.Called(ctx, gameID, platformID)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) RemovePlatformFromGame(ctx context.Context, gameID, platformID uint) error {
+	args := m.Called(ctx, gameID, platformID)
+	return args.Error(0)
+}
+
 func (m *MockGameRepository) CreateGenre(ctx context.Context, genre *models.Genre) error {
 	args := m.Called(ctx, genre)
 	return args.Error(0)
@@ -59,6 +92,34 @@ func (m *MockGameRepository) GetAllGenres(ctx context.Context) ([]models.Genre,
 	return args.Get(0).([]models.Genre), args.Error(1)
 }
 
+func (m *MockGameRepository) FindGenresByIDs(ctx context.Context, ids []uint) ([]models.Genre, error) {
+	args := m.Called(ctx, ids)
+	return args.Get(0).([]models.Genre), args.Error(1)
+}
+
+func (m *MockGameRepository) GetGenreByID(ctx context.Context, id uint) (*models.Genre, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Genre), args.Error(1)
+}
+
+func (m *MockGameRepository) UpdateGenre(ctx context.Context, genre *models.Genre) error {
+	args := m.Called(ctx, genre)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) DeleteGenre(ctx context.Context, id uint, cascade bool) error {
+	args := m.Called(ctx, id, cascade)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) MergeGenres(ctx context.Context, sourceID, targetID uint) error {
+	args := m.Called(ctx, sourceID, targetID)
+	return args.Error(0)
+}
+
 func (m *MockGameRepository) CreatePlatform(ctx context.Context, platform *models.Platform) error {
 	args := m.Called(ctx, platform)
 	return args.Error(0)
@@ -69,11 +130,254 @@ func (m *MockGameRepository) GetAllPlatforms(ctx context.Context) ([]models.Plat
 	return args.Get(0).([]models.Platform), args.Error(1)
 }
 
+func (m *MockGameRepository) FindPlatformsByIDs(ctx context.Context, ids []uint) ([]models.Platform, error) {
+	args := m.Called(ctx, ids)
+	return args.Get(0).([]models.Platform), args.Error(1)
+}
+
+func (m *MockGameRepository) GetPlatformByID(ctx context.Context, id uint) (*models.Platform, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Platform), args.Error(1)
+}
+
+func (m *MockGameRepository) UpdatePlatform(ctx context.Context, platform *models.Platform) error {
+	args := m.Called(ctx, platform)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) DeletePlatform(ctx context.Context, id uint, cascade bool) error {
+	args := m.Called(ctx, id, cascade)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) MergePlatforms(ctx context.Context, sourceID, targetID uint) error {
+	args := m.Called(ctx, sourceID, targetID)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) CreateCompany(ctx context.Context, company *models.Company) error {
+	args := m.Called(ctx, company)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) GetAllCompanies(ctx context.Context) ([]models.Company, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]models.Company), args.Error(1)
+}
+
+func (m *MockGameRepository) GetCompanyByID(ctx context.Context, id uint) (*models.Company, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Company), args.Error(1)
+}
+
+func (m *MockGameRepository) FindCompaniesByIDs(ctx context.Context, ids []uint) ([]models.Company, error) {
+	args := m.Called(ctx, ids)
+	return args.Get(0).([]models.Company), args.Error(1)
+}
+
+func (m *MockGameRepository) UpdateCompany(ctx context.Context, company *models.Company) error {
+	args := m.Called(ctx, company)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) DeleteCompany(ctx context.Context, id uint, cascade bool) error {
+	args := m.Called(ctx, id, cascade)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) CreateFranchise(ctx context.Context, franchise *models.Franchise) error {
+	args := m.Called(ctx, franchise)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) GetAllFranchises(ctx context.Context) ([]models.Franchise, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]models.Franchise), args.Error(1)
+}
+
+func (m *MockGameRepository) GetFranchiseByID(ctx context.Context, id uint) (*models.Franchise, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Franchise), args.Error(1)
+}
+
+func (m *MockGameRepository) UpdateFranchise(ctx context.Context, franchise *models.Franchise) error {
+	args := m.Called(ctx, franchise)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) DeleteFranchise(ctx context.Context, id uint) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) CreateRelation(ctx context.Context, relation *models.GameRelation) error {
+	args := m.Called(ctx, relation)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) DeleteRelation(ctx context.Context, gameID, relationID uint) error {
+	args := m.Called(ctx, gameID, relationID)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) GetRelatedGames(ctx context.Context, game *models.Game) (*models.RelatedGames, error) {
+	args := m.Called(ctx, game)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.RelatedGames), args.Error(1)
+}
+
+func (m *MockGameRepository) GetGameTranslations(ctx context.Context, gameID uint) ([]models.GameTranslation, error) {
+	args := m.Called(ctx, gameID)
+	return args.Get(0).([]models.GameTranslation), args.Error(1)
+}
+
+func (m *MockGameRepository) FindGameTranslations(ctx context.Context, gameIDs []uint, locales []string) ([]models.GameTranslation, error) {
+	args := m.Called(ctx, gameIDs, locales)
+	return args.Get(0).([]models.GameTranslation), args.Error(1)
+}
+
+func (m *MockGameRepository) SaveGameTranslation(ctx context.Context, translation *models.GameTranslation) error {
+	args := m.Called(ctx, translation)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) DeleteGameTranslation(ctx context.Context, gameID uint, locale string) error {
+	args := m.Called(ctx, gameID, locale)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) FindGameMedia(ctx context.Context, gameIDs []uint) ([]models.GameMedia, error) {
+	args := m.Called(ctx, gameIDs)
+	return args.Get(0).([]models.GameMedia), args.Error(1)
+}
+
+func (m *MockGameRepository) AddGameMedia(ctx context.Context, media *models.GameMedia) error {
+	args := m.Called(ctx, media)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) ReorderGameMedia(ctx context.Context, gameID uint, mediaIDs []uint) error {
+	args := m.Called(ctx, gameID, mediaIDs)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) DeleteGameMedia(ctx context.Context, gameID, mediaID uint) error {
+	args := m.Called(ctx, gameID, mediaID)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) CreateTag(ctx context.Context, tag *models.Tag) error {
+	args := m.Called(ctx, tag)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) GetTagByID(ctx context.Context, id uint) (*models.Tag, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Tag), args.Error(1)
+}
+
+func (m *MockGameRepository) GetTagByName(ctx context.Context, name string) (*models.Tag, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Tag), args.Error(1)
+}
+
+func (m *MockGameRepository) GetTags(ctx context.Context, status string) ([]models.Tag, error) {
+	args := m.Called(ctx, status)
+	return args.Get(0).([]models.Tag), args.Error(1)
+}
+
+func (m *MockGameRepository) UpdateTagStatus(ctx context.Context, id uint, status string) error {
+	args := m.Called(ctx, id, status)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) VoteGameTag(ctx context.Context, gameID, tagID uint, voter string) (*models.GameTag, error) {
+	args := m.Called(ctx, gameID, tagID, voter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.GameTag), args.Error(1)
+}
+
+func (m *MockGameRepository) UnvoteGameTag(ctx context.Context, gameID, tagID uint, voter string) error {
+	args := m.Called(ctx, gameID, tagID, voter)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) GetGameByExternalID(ctx context.Context, provider, externalID string) (*models.Game, error) {
+	args := m.Called(ctx, provider, externalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Game), args.Error(1)
+}
+
+func (m *MockGameRepository) FindExternalIDs(ctx context.Context, ids []models.GameExternalID) ([]models.GameExternalID, error) {
+	args := m.Called(ctx, ids)
+	return args.Get(0).([]models.GameExternalID), args.Error(1)
+}
+
+func (m *MockGameRepository) GetGameByUUID(ctx context.Context, uuid string) (*models.Game, error) {
+	args := m.Called(ctx, uuid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Game), args.Error(1)
+}
+
+func (m *MockGameRepository) GetGameBySlug(ctx context.Context, slug string) (*models.Game, error) {
+	args := m.Called(ctx, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Game), args.Error(1)
+}
+
+func (m *MockGameRepository) FindGameIDByUUID(ctx context.Context, uuid string) (uint, error) {
+	args := m.Called(ctx, uuid)
+	return args.Get(0).(uint), args.Error(1)
+}
+
+func (m *MockGameRepository) FindGameIDBySlug(ctx context.Context, slug string) (uint, error) {
+	args := m.Called(ctx, slug)
+	return args.Get(0).(uint), args.Error(1)
+}
+
+func (m *MockGameRepository) FindSlugRedirect(ctx context.Context, slug string) (*models.GameSlug, error) {
+	args := m.Called(ctx, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.GameSlug), args.Error(1)
+}
+
+func (m *MockGameRepository) FindSlugs(ctx context.Context, base string) ([]models.GameSlug, error) {
+	args := m.Called(ctx, base)
+	return args.Get(0).([]models.GameSlug), args.Error(1)
+}
+
 func setupTest() (*MockGameRepository, service.GameService) {
 	mockRepo := new(MockGameRepository)
 	logger := logrus.New()
 	logger.SetOutput(logrus.StandardLogger().Out)
-	service := service.NewGameService(mockRepo, logger)
+	service := service.NewGameService(mockRepo, logger, "fr")
 
 	return mockRepo, service
 }
@@ -86,6 +390,7 @@ func TestCreateGame(t *testing.T) {
 			Title:       "Test Game",
 			Description: "Test Description",
 		}
+		mockRepo.On("FindSlugs", ctx, mock.Anything).Return([]models.GameSlug{}, nil)
 		mockRepo.On("Create", ctx, mock.MatchedBy(func(g *models.Game) bool {
 			return g.Title == game.Title && g.Description == game.Description
 		})).Return(nil)
@@ -107,6 +412,7 @@ func TestCreateGame(t *testing.T) {
 
 		assert.Error(t, err)
 		assert.Equal(t, "le titre du jeu est obligatoire", err.Error())
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
 		mockRepo.AssertNotCalled(t, "Create")
 	})
 
@@ -118,6 +424,7 @@ func TestCreateGame(t *testing.T) {
 			Description: "Test Description",
 		}
 		expectedErr := errors.New("erreur de base de données")
+		mockRepo.On("FindSlugs", ctx, mock.Anything).Return([]models.GameSlug{}, nil)
 		mockRepo.On("Create", ctx, mock.MatchedBy(func(g *models.Game) bool {
 			return g.Title == game.Title && g.Description == game.Description
 		})).Return(expectedErr)
@@ -130,6 +437,1218 @@ func TestCreateGame(t *testing.T) {
 	})
 }
 
+func TestValidateGame(t *testing.T) {
+	t.Run("échec validation - toutes les violations sont retournées", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		game := &models.Game{
+			Title:         "Test Game",
+			ImageURL:      "not-an-url",
+			AverageRating: 7,
+			Genres:        []models.Genre{{ID: 1}, {ID: 42}},
+		}
+		mockRepo.On("FindGenresByIDs", ctx, []uint{1, 42}).Return([]models.Genre{{ID: 1, Name: "Action"}}, nil)
+
+		err := service.CreateGame(ctx, game)
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		fields := apperrors.FieldsOf(err)
+		assert.Len(t, fields, 3)
+		assert.Equal(t, "image_url", fields[0].Field)
+		assert.Equal(t, "average_rating", fields[1].Field)
+		assert.Equal(t, "genres[1]", fields[2].Field)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("échec validation - prix et devise invalides", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		game := &models.Game{
+			Title:    "Test Game",
+			Price:    decimal.RequireFromString("-1.50"),
+			Currency: "XYZ",
+			Prices: []models.GamePrice{
+				{Region: "EU", Amount: decimal.RequireFromString("19.99"), Currency: "eur"},
+			},
+		}
+
+		err := service.CreateGame(ctx, game)
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		fields := apperrors.FieldsOf(err)
+		assert.Len(t, fields, 2)
+		assert.Equal(t, "price", fields[0].Field)
+		assert.Equal(t, "currency", fields[1].Field)
+		assert.Equal(t, "EUR", game.Prices[0].Currency)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("échec validation - deux prix pour le même périmètre", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		game := &models.Game{
+			Title: "Test Game",
+			Prices: []models.GamePrice{
+				{Region: "EU", Amount: decimal.RequireFromString("19.99"), Currency: "EUR"},
+				{Region: "US", Amount: decimal.RequireFromString("19.99"), Currency: "USD"},
+				{Region: "eu", Amount: decimal.RequireFromString("14.99"), Currency: "EUR"},
+			},
+		}
+
+		err := service.CreateGame(ctx, game)
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		fields := apperrors.FieldsOf(err)
+		assert.Len(t, fields, 1)
+		assert.Equal(t, "prices[2]", fields[0].Field)
+		assert.Equal(t, "le jeu a déjà un prix pour toutes les plateformes dans cette région", fields[0].Message)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("échec validation - deux sorties pour le même périmètre", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		platformID := uint(2)
+		game := &models.Game{
+			Title: "Test Game",
+			Releases: []models.GameRelease{
+				{PlatformID: &platformID, Region: "jp", Status: models.ReleaseAnnounced},
+				{PlatformID: &platformID, Region: "JP", Status: models.ReleaseDelayed},
+				{Region: "JP", Status: models.ReleaseAnnounced},
+			},
+		}
+		mockRepo.On("FindPlatformsByIDs", ctx, mock.Anything).Return([]models.Platform{{ID: 2, Name: "PS5"}}, nil)
+
+		err := service.CreateGame(ctx, game)
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		fields := apperrors.FieldsOf(err)
+		assert.Len(t, fields, 1)
+		assert.Equal(t, "releases[1]", fields[0].Field)
+		assert.Equal(t, "le jeu a déjà une sortie pour la plateforme 2 dans cette région", fields[0].Message)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("échec création genre - nom manquant", func(t *testing.T) {
+		mockRepo, service := setupTest()
+
+		err := service.CreateGenre(context.Background(), &models.Genre{})
+
+		assert.Error(t, err)
+		assert.Equal(t, "le nom du genre est obligatoire", err.Error())
+		mockRepo.AssertNotCalled(t, "CreateGenre")
+	})
+}
+
+func TestUpdateGame(t *testing.T) {
+	t.Run("succès mise à jour jeu - champs appliqués", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		createdTime := time.Now().Add(-24 * time.Hour)
+		existingGame := &models.Game{ID: 1, Title: "Old Title", Description: "Old Description", CreatedAt: createdTime}
+		updatedGame := &models.Game{ID: 1, Title: "New Title", Description: "New Description"}
+		mockRepo.On("GetByID", ctx, uint(1)).Return(existingGame, nil).Once()
+		mockRepo.On("FindSlugs", ctx, mock.Anything).Return([]models.GameSlug{}, nil)
+		mockRepo.On("Update", ctx, mock.MatchedBy(func(g *models.Game) bool {
+			return g.ID == 1 && g.Title == "New Title" && g.Description == "New Description" && g.CreatedAt.Equal(createdTime)
+		})).Return(nil)
+		mockRepo.On("GetByID", ctx, uint(1)).Return(&models.Game{ID: 1, Title: "New Title", Description: "New Description"}, nil).Once()
+
+		err := service.UpdateGame(ctx, updatedGame)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "New Title", updatedGame.Title)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("échec mise à jour jeu - jeu non trouvé", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		expectedErr := apperrors.NotFound("game not found")
+		mockRepo.On("GetByID", ctx, uint(1)).Return(nil, expectedErr)
+
+		err := service.UpdateGame(ctx, &models.Game{ID: 1, Title: "New Title"})
+
+		assert.ErrorIs(t, err, apperrors.ErrNotFound)
+		mockRepo.AssertNotCalled(t, "Update")
+	})
+
+	t.Run("échec mise à jour jeu - jeu non trouvé prioritaire sur la validation", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		mockRepo.On("GetByID", ctx, uint(404)).Return(nil, apperrors.NotFound("game not found"))
+
+		err := service.UpdateGame(ctx, &models.Game{ID: 404, Price: decimal.NewFromInt(-1)})
+
+		assert.ErrorIs(t, err, apperrors.ErrNotFound)
+		assert.Empty(t, apperrors.FieldsOf(err))
+		mockRepo.AssertNotCalled(t, "Update")
+	})
+}
+
+func TestRestoreGame(t *testing.T) {
+	t.Run("succès restauration jeu", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		mockRepo.On("Restore", ctx, uint(1)).Return(nil)
+		mockRepo.On("GetByID", ctx, uint(1)).Return(&models.Game{ID: 1, Title: "Test Game"}, nil)
+
+		game, err := service.RestoreGame(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(1), game.ID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("échec restauration jeu - jeu non supprimé", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		mockRepo.On("Restore", ctx, uint(1)).Return(apperrors.NotFound("deleted game not found"))
+
+		game, err := service.RestoreGame(ctx, 1)
+
+		assert.ErrorIs(t, err, apperrors.ErrNotFound)
+		assert.Nil(t, game)
+		mockRepo.AssertNotCalled(t, "GetByID")
+	})
+}
+
+func TestAutocompleteTitles(t *testing.T) {
+	t.Run("succès autocomplétion - limite bornée", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		expected := []models.TitleSuggestion{{ID: 1, Title: "The Legend of Zelda", Similarity: 0.8}}
+		mockRepo.On("AutocompleteTitles", ctx, "zelda", 50).Return(expected, nil)
+
+		suggestions, err := service.AutocompleteTitles(ctx, " zelda ", 500)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, suggestions)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("échec autocomplétion - préfixe vide", func(t *testing.T) {
+		mockRepo, service := setupTest()
+
+		_, err := service.AutocompleteTitles(context.Background(), "  ", 0)
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		mockRepo.AssertNotCalled(t, "AutocompleteTitles")
+	})
+}
+
+func TestPatchGame(t *testing.T) {
+	t.Run("succès modification partielle", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		existingGame := &models.Game{ID: 1, Title: "Title", Description: "Description", Developer: "Dev"}
+		mockRepo.On("GetByID", ctx, uint(1)).Return(existingGame, nil)
+		mockRepo.On("FindSlugs", ctx, mock.Anything).Return([]models.GameSlug{}, nil)
+		mockRepo.On("Update", ctx, mock.MatchedBy(func(g *models.Game) bool {
+			return g.Title == "Title" && g.Description == "Patched" && g.Developer == ""
+		})).Return(nil)
+
+		_, err := service.PatchGame(ctx, 1, []byte(`{"description": "Patched", "developer": null}`))
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("échec modification partielle - patch invalide", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		mockRepo.On("GetByID", ctx, uint(1)).Return(&models.Game{ID: 1, Title: "Title"}, nil)
+
+		game, err := service.PatchGame(ctx, 1, []byte(`{invalid`))
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		assert.Nil(t, game)
+		mockRepo.AssertNotCalled(t, "Update")
+	})
+}
+
+func TestListGamesFilter(t *testing.T) {
+	t.Run("échec liste jeux - mode de correspondance invalide", func(t *testing.T) {
+		mockRepo, service := setupTest()
+
+		_, err := service.ListGames(context.Background(), &models.GameFilter{
+			Genres:     []string{"RPG", "Action"},
+			GenreMatch: "both",
+		})
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		assert.Equal(t, "genre_match", apperrors.FieldsOf(err)[0].Field)
+		mockRepo.AssertNotCalled(t, "List")
+	})
+
+	t.Run("succès liste jeux - tous les genres", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		filter := &models.GameFilter{Genres: []string{"RPG", "Action"}, GenreMatch: models.MatchAll}
+		expected := &models.GameResponse{Games: []models.Game{{ID: 1, Title: "Game"}}, TotalCount: 1}
+		mockRepo.On("List", ctx, filter).Return(expected, nil)
+
+		response, err := service.ListGames(ctx, filter)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, response)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestGenreManagement(t *testing.T) {
+	t.Run("succès renommage genre - date de création conservée", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		mockRepo.On("GetGenreByID", ctx, uint(1)).Return(&models.Genre{ID: 1, Name: "Aventure", CreatedAt: createdAt}, nil)
+		mockRepo.On("UpdateGenre", ctx, mock.MatchedBy(func(g *models.Genre) bool {
+			return g.Name == "Adventure" && g.CreatedAt.Equal(createdAt)
+		})).Return(nil)
+
+		err := service.UpdateGenre(ctx, &models.Genre{ID: 1, Name: "Adventure"})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("échec suppression genre - encore utilisé", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		mockRepo.On("DeleteGenre", ctx, uint(1), false).Return(apperrors.Conflict("genre is still used by 3 games"))
+
+		err := service.DeleteGenre(ctx, 1, false)
+
+		assert.ErrorIs(t, err, apperrors.ErrConflict)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("succès fusion genres", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		target := &models.Genre{ID: 2, Name: "Adventure"}
+		mockRepo.On("MergeGenres", ctx, uint(1), uint(2)).Return(nil)
+		mockRepo.On("GetGenreByID", ctx, uint(2)).Return(target, nil)
+
+		genre, err := service.MergeGenres(ctx, 1, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, target, genre)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("échec fusion genres - même genre", func(t *testing.T) {
+		mockRepo, service := setupTest()
+
+		_, err := service.MergeGenres(context.Background(), 1, 1)
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		mockRepo.AssertNotCalled(t, "MergeGenres")
+	})
+}
+
+func TestGameAssociations(t *testing.T) {
+	t.Run("succès création jeu - genres par identifiant", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		mockRepo.On("FindGenresByIDs", ctx, []uint{2, 2}).Return([]models.Genre{{ID: 2, Name: "RPG"}}, nil)
+		mockRepo.On("FindSlugs", ctx, mock.Anything).Return([]models.GameSlug{}, nil)
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(g *models.Game) bool {
+			return len(g.Genres) == 1 && g.Genres[0].Name == "RPG" && g.GenreIDs == nil
+		})).Return(nil)
+
+		err := service.CreateGame(ctx, &models.Game{Title: "Game", GenreIDs: []uint{2, 2}})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("échec création jeu - plateforme inconnue", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		mockRepo.On("FindPlatformsByIDs", ctx, []uint{7}).Return([]models.Platform{}, nil)
+
+		err := service.CreateGame(ctx, &models.Game{Title: "Game", PlatformIDs: []uint{7}})
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		assert.Equal(t, "platform_ids[0]", apperrors.FieldsOf(err)[0].Field)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("succès ajout genre à un jeu", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		game := &models.Game{ID: 1, Title: "Game", Genres: []models.Genre{{ID: 2, Name: "RPG"}}}
+		mockRepo.On("GetByID", ctx, uint(1)).Return(game, nil)
+		mockRepo.On("GetGenreByID", ctx, uint(2)).Return(&models.Genre{ID: 2, Name: "RPG"}, nil)
+		mockRepo.On("AddGenreToGame", ctx, uint(1), uint(2)).Return(nil)
+
+		updated, err := service.AddGenreToGame(ctx, 1, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, game, updated)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("échec ajout plateforme à un jeu - plateforme inconnue", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		mockRepo.On("GetByID", ctx, uint(1)).Return(&models.Game{ID: 1, Title: "Game"}, nil)
+		mockRepo.On("GetPlatformByID", ctx, uint(9)).Return(nil, apperrors.NotFound("platform not found"))
+
+		_, err := service.AddPlatformToGame(ctx, 1, 9)
+
+		assert.ErrorIs(t, err, apperrors.ErrNotFound)
+		mockRepo.AssertNotCalled(t, "AddPlatformToGame")
+	})
+}
+
+func TestCompanies(t *testing.T) {
+	t.Run("clé de dédoublonnage", func(t *testing.T) {
+		assert.Equal(t, "nintendo", models.CompanyKey("Nintendo"))
+		assert.Equal(t, "nintendo", models.CompanyKey("nintendo"))
+		assert.Equal(t, "nintendo", models.CompanyKey("Nintendo Co., Ltd."))
+		assert.Equal(t, "square enix", models.CompanyKey("Square-Enix Inc"))
+	})
+
+	t.Run("succès création société", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		mockRepo.On("CreateCompany", ctx, mock.MatchedBy(func(c *models.Company) bool {
+			return c.Name == "Nintendo Co., Ltd." && c.Key == "nintendo"
+		})).Return(nil)
+
+		err := service.CreateCompany(ctx, &models.Company{Name: "Nintendo Co., Ltd."})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("succès création jeu - développeur par identifiant", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		mockRepo.On("FindCompaniesByIDs", ctx, []uint{4}).Return([]models.Company{{ID: 4, Name: "Nintendo"}}, nil)
+		mockRepo.On("FindSlugs", ctx, mock.Anything).Return([]models.GameSlug{}, nil)
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(g *models.Game) bool {
+			return len(g.Developers) == 1 && g.Developer == "Nintendo"
+		})).Return(nil)
+
+		err := service.CreateGame(ctx, &models.Game{Title: "Game", DeveloperIDs: []uint{4}})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("échec création jeu - éditeur inconnu", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		mockRepo.On("FindCompaniesByIDs", ctx, []uint{9}).Return([]models.Company{}, nil)
+
+		err := service.CreateGame(ctx, &models.Game{Title: "Game", PublisherIDs: []uint{9}})
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		assert.Equal(t, "publisher_ids[0]", apperrors.FieldsOf(err)[0].Field)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+}
+
+func TestGameRelations(t *testing.T) {
+	t.Run("succès ajout relation - DLC", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		dlc := &models.Game{ID: 2, Title: "Hearts of Stone"}
+		relation := &models.GameRelation{GameID: 1, RelatedGameID: 2, Type: models.RelationDLC}
+		mockRepo.On("GetByID", ctx, uint(1)).Return(&models.Game{ID: 1, Title: "The Witcher 3"}, nil)
+		mockRepo.On("GetByID", ctx, uint(2)).Return(dlc, nil)
+		mockRepo.On("CreateRelation", ctx, relation).Return(nil)
+
+		err := service.AddGameRelation(ctx, relation)
+
+		assert.NoError(t, err)
+		assert.Equal(t, dlc, relation.RelatedGame)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("échec ajout relation - type inconnu et jeu lié à lui-même", func(t *testing.T) {
+		mockRepo, service := setupTest()
+
+		err := service.AddGameRelation(context.Background(), &models.GameRelation{GameID: 1, RelatedGameID: 1, Type: "mod"})
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		assert.Len(t, apperrors.FieldsOf(err), 2)
+		mockRepo.AssertNotCalled(t, "CreateRelation")
+	})
+
+	t.Run("échec création jeu - franchise inconnue", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		franchiseID := uint(5)
+		mockRepo.On("GetFranchiseByID", ctx, franchiseID).Return(nil, apperrors.NotFound("franchise not found"))
+
+		err := service.CreateGame(ctx, &models.Game{Title: "Game", FranchiseID: &franchiseID})
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		assert.Equal(t, "franchise_id", apperrors.FieldsOf(err)[0].Field)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+}
+
+func TestGameReleases(t *testing.T) {
+	t.Run("succès création jeu - date approximative ramenée au début du trimestre", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		date := time.Date(time.Now().Year()+1, time.August, 15, 0, 0, 0, 0, time.UTC)
+		game := &models.Game{
+			Title:    "Game",
+			Releases: []models.GameRelease{{Region: "eu", Date: &date, Precision: models.PrecisionQuarter}},
+		}
+		mockRepo.On("FindSlugs", ctx, mock.Anything).Return([]models.GameSlug{}, nil)
+		mockRepo.On("Create", ctx, game).Return(nil)
+
+		err := service.CreateGame(ctx, game)
+
+		assert.NoError(t, err)
+		release := game.Releases[0]
+		assert.Equal(t, time.Date(date.Year(), time.July, 1, 0, 0, 0, 0, time.UTC), *release.Date)
+		assert.Equal(t, "EU", release.Region)
+		assert.Equal(t, models.ReleaseAnnounced, release.Status)
+		assert.Equal(t, *release.Date, game.ReleaseDate)
+	})
+
+	t.Run("échec création jeu - sortie invalide", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		date := time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)
+		platformID := uint(3)
+		mockRepo.On("FindPlatformsByIDs", ctx, []uint{3}).Return([]models.Platform{}, nil)
+
+		err := service.CreateGame(ctx, &models.Game{
+			Title:       "Game",
+			ReleaseDate: time.Date(2020, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Releases:    []models.GameRelease{{PlatformID: &platformID, Date: &date, Status: "soon"}},
+		})
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		fields := make([]string, 0)
+		for _, field := range apperrors.FieldsOf(err) {
+			fields = append(fields, field.Field)
+		}
+		assert.ElementsMatch(t, []string{"releases[0].date", "releases[0].status", "releases[0].platform_id"}, fields)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+}
+
+func TestAgeRatings(t *testing.T) {
+	t.Run("succès création jeu - âge minimum déduit de la classification", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		game := &models.Game{
+			Title:       "Game",
+			ReleaseDate: time.Date(2020, time.May, 1, 0, 0, 0, 0, time.UTC),
+			AgeRatings: []models.AgeRating{
+				{Board: "pegi", Rating: "16", Descriptors: []string{" Violence "}},
+				{Board: "esrb", Rating: "e10+"},
+			},
+		}
+		mockRepo.On("FindSlugs", ctx, mock.Anything).Return([]models.GameSlug{}, nil)
+		mockRepo.On("Create", ctx, game).Return(nil)
+
+		err := service.CreateGame(ctx, game)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.AgeRating{Board: "PEGI", Rating: "16", MinimumAge: 16, Descriptors: []string{"Violence"}}, game.AgeRatings[0])
+		assert.Equal(t, 10, game.AgeRatings[1].MinimumAge)
+	})
+
+	t.Run("échec création jeu - classification inconnue", func(t *testing.T) {
+		mockRepo, service := setupTest()
+
+		err := service.CreateGame(context.Background(), &models.Game{
+			Title:       "Game",
+			ReleaseDate: time.Date(2020, time.May, 1, 0, 0, 0, 0, time.UTC),
+			AgeRatings: []models.AgeRating{
+				{Board: "PEGI", Rating: "15"},
+				{Board: "PEGI", Rating: "18"},
+				{Board: "BBFC", Rating: "15"},
+			},
+		})
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		fields := make([]string, 0)
+		for _, field := range apperrors.FieldsOf(err) {
+			fields = append(fields, field.Field)
+		}
+		assert.ElementsMatch(t, []string{"age_ratings[0].rating", "age_ratings[1].board", "age_ratings[2].board"}, fields)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("échec liste jeux - âge maximum négatif", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		maxAge := -1
+
+		_, err := service.ListGames(context.Background(), &models.GameFilter{MaxAge: &maxAge, RatingBoard: "pegi"})
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		assert.Equal(t, "max_age", apperrors.FieldsOf(err)[0].Field)
+		mockRepo.AssertNotCalled(t, "List")
+	})
+}
+
+func TestGameTranslations(t *testing.T) {
+	t.Run("succès localisation - chaîne de repli par champ", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		games := []models.Game{
+			{ID: 1, Title: "Le Jeu", Description: "Une description"},
+			{ID: 2, Title: "Autre Jeu", Description: "Autre description"},
+		}
+		mockRepo.On("FindGameTranslations", ctx, []uint{1, 2}, []string{"en-GB", "en", "de"}).Return([]models.GameTranslation{
+			{GameID: 1, Locale: "en", Title: "The Game"},
+			{GameID: 1, Locale: "de", Title: "Das Spiel", Description: "Eine Beschreibung"},
+		}, nil)
+
+		err := service.LocalizeGames(ctx, games, []string{"en-gb", "de", "fr", "es"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "The Game", games[0].Title)
+		assert.Equal(t, "Eine Beschreibung", games[0].Description)
+		assert.Equal(t, "en", games[0].Locale)
+		assert.Equal(t, "Autre Jeu", games[1].Title)
+		assert.Equal(t, "fr", games[1].Locale)
+	})
+
+	t.Run("succès localisation jeux liés - série et relations", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		related := &models.RelatedGames{
+			Series:           []models.Game{{ID: 2, Title: "Le Jeu 2"}},
+			Relations:        []models.GameRelation{{RelatedGameID: 3, Type: "dlc", RelatedGame: &models.Game{ID: 3, Title: "Extension"}}},
+			InverseRelations: []models.GameRelation{{GameID: 4, Type: "remaster", Game: &models.Game{ID: 4, Title: "Le Jeu Remasterisé"}}},
+		}
+		mockRepo.On("FindGameTranslations", ctx, []uint{2, 3, 4}, []string{"en"}).Return([]models.GameTranslation{
+			{GameID: 2, Locale: "en", Title: "The Game 2"},
+			{GameID: 3, Locale: "en", Title: "Expansion"},
+			{GameID: 4, Locale: "en", Title: "The Game Remastered"},
+		}, nil).Once()
+
+		err := service.LocalizeRelatedGames(ctx, related, []string{"en"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "The Game 2", related.Series[0].Title)
+		assert.Equal(t, "Expansion", related.Relations[0].RelatedGame.Title)
+		assert.Equal(t, "en", related.Relations[0].RelatedGame.Locale)
+		assert.Equal(t, "The Game Remastered", related.InverseRelations[0].Game.Title)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("succès localisation - langue par défaut demandée", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		games := []models.Game{{ID: 1, Title: "Le Jeu"}}
+
+		err := service.LocalizeGames(context.Background(), games, []string{"fr", "en"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Le Jeu", games[0].Title)
+		assert.Equal(t, "fr", games[0].Locale)
+		mockRepo.AssertNotCalled(t, "FindGameTranslations")
+	})
+
+	t.Run("succès enregistrement traduction - langue normalisée", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		translation := &models.GameTranslation{GameID: 1, Locale: "en-us", Title: " The Game "}
+		mockRepo.On("GetByID", ctx, uint(1)).Return(&models.Game{ID: 1}, nil)
+		mockRepo.On("SaveGameTranslation", ctx, translation).Return(nil)
+
+		err := service.SetGameTranslation(ctx, translation)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "en-US", translation.Locale)
+		assert.Equal(t, "The Game", translation.Title)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("échec enregistrement traduction - langue par défaut et traduction vide", func(t *testing.T) {
+		mockRepo, service := setupTest()
+
+		err := service.SetGameTranslation(context.Background(), &models.GameTranslation{GameID: 1, Locale: "FR"})
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		fields := make([]string, 0)
+		for _, field := range apperrors.FieldsOf(err) {
+			fields = append(fields, field.Field)
+		}
+		assert.ElementsMatch(t, []string{"locale", "title"}, fields)
+		mockRepo.AssertNotCalled(t, "SaveGameTranslation")
+	})
+}
+
+func TestGameMedia(t *testing.T) {
+	t.Run("succès ajout média - type normalisé", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		media := &models.GameMedia{GameID: 1, Type: " Screenshot ", URL: "https://cdn.example.com/1.png", Width: 1920, Height: 1080}
+		mockRepo.On("GetByID", ctx, uint(1)).Return(&models.Game{ID: 1}, nil)
+		mockRepo.On("AddGameMedia", ctx, media).Return(nil)
+
+		err := service.AddGameMedia(ctx, media)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.MediaScreenshot, media.Type)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("échec ajout média - type et URL invalides", func(t *testing.T) {
+		mockRepo, service := setupTest()
+
+		err := service.AddGameMedia(context.Background(), &models.GameMedia{GameID: 1, Type: "poster", URL: "cover.png"})
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		assert.Len(t, apperrors.FieldsOf(err), 2)
+		mockRepo.AssertNotCalled(t, "AddGameMedia")
+	})
+
+	t.Run("succès réorganisation galerie", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		mockRepo.On("GetByID", ctx, uint(1)).Return(&models.Game{ID: 1}, nil)
+		mockRepo.On("FindGameMedia", ctx, []uint{1}).Return([]models.GameMedia{
+			{ID: 10, GameID: 1, Position: 0},
+			{ID: 11, GameID: 1, Position: 1},
+		}, nil)
+		mockRepo.On("ReorderGameMedia", ctx, uint(1), []uint{11, 10}).Return(nil)
+
+		media, err := service.ReorderGameMedia(ctx, 1, []uint{11, 10})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(11), media[0].ID)
+		assert.Equal(t, 0, media[0].Position)
+		assert.Equal(t, 1, media[1].Position)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("échec réorganisation galerie - média étranger ou manquant", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		mockRepo.On("GetByID", ctx, uint(1)).Return(&models.Game{ID: 1}, nil)
+		mockRepo.On("FindGameMedia", ctx, []uint{1}).Return([]models.GameMedia{
+			{ID: 10, GameID: 1},
+			{ID: 11, GameID: 1},
+		}, nil)
+
+		_, err := service.ReorderGameMedia(ctx, 1, []uint{12})
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		assert.Equal(t, "media_ids[0]", apperrors.FieldsOf(err)[0].Field)
+
+		_, err = service.ReorderGameMedia(ctx, 1, []uint{10})
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		assert.Equal(t, "media_ids", apperrors.FieldsOf(err)[0].Field)
+		mockRepo.AssertNotCalled(t, "ReorderGameMedia")
+	})
+}
+
+func TestSystemRequirements(t *testing.T) {
+	t.Run("succès création jeu - niveau minimum par défaut", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		game := &models.Game{
+			Title:       "Game",
+			ReleaseDate: time.Date(2020, time.May, 1, 0, 0, 0, 0, time.UTC),
+			SystemRequirements: []models.SystemRequirements{
+				{PlatformID: 1, OS: " Windows 10 ", MemoryMB: 8192, StorageMB: 51200},
+				{PlatformID: 1, Tier: "Recommended", MemoryMB: 16384},
+			},
+		}
+		mockRepo.On("FindPlatformsByIDs", ctx, []uint{1, 1}).Return([]models.Platform{{ID: 1, Name: "PC"}}, nil)
+		mockRepo.On("FindSlugs", ctx, mock.Anything).Return([]models.GameSlug{}, nil)
+		mockRepo.On("Create", ctx, game).Return(nil)
+
+		err := service.CreateGame(ctx, game)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.RequirementsMinimum, game.SystemRequirements[0].Tier)
+		assert.Equal(t, "Windows 10", game.SystemRequirements[0].OS)
+		assert.Equal(t, models.RequirementsRecommended, game.SystemRequirements[1].Tier)
+	})
+
+	t.Run("échec création jeu - configuration en double et plateforme inconnue", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		mockRepo.On("FindPlatformsByIDs", ctx, []uint{1, 1, 9}).Return([]models.Platform{{ID: 1, Name: "PC"}}, nil)
+
+		err := service.CreateGame(ctx, &models.Game{
+			Title:       "Game",
+			ReleaseDate: time.Date(2020, time.May, 1, 0, 0, 0, 0, time.UTC),
+			SystemRequirements: []models.SystemRequirements{
+				{PlatformID: 1, MemoryMB: 8192},
+				{PlatformID: 1, Tier: "minimum", MemoryMB: 4096},
+				{PlatformID: 9, MemoryMB: -1},
+			},
+		})
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		fields := make([]string, 0)
+		for _, field := range apperrors.FieldsOf(err) {
+			fields = append(fields, field.Field)
+		}
+		assert.ElementsMatch(t, []string{"system_requirements[1].tier", "system_requirements[2].memory_mb", "system_requirements[2].platform_id"}, fields)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("échec liste jeux - mémoire disponible nulle", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		memory := 0
+
+		_, err := service.ListGames(context.Background(), &models.GameFilter{MaxMemoryGB: &memory})
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		assert.Equal(t, "max_memory_gb", apperrors.FieldsOf(err)[0].Field)
+		mockRepo.AssertNotCalled(t, "List")
+	})
+}
+
+func TestTags(t *testing.T) {
+	t.Run("succès proposition tag - nom normalisé et en attente", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		mockRepo.On("GetTagByName", ctx, "co-op local").Return(nil, apperrors.NotFound("tag not found"))
+		mockRepo.On("CreateTag", ctx, mock.MatchedBy(func(tag *models.Tag) bool {
+			return tag.Name == "co-op local" && tag.Status == models.TagPending
+		})).Return(nil)
+
+		tag, created, err := service.SuggestTag(ctx, "  Co-op   Local ")
+
+		assert.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, "co-op local", tag.Name)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("succès vote tag - tag existant", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		tag := &models.Tag{ID: 3, Name: "roguelike", Status: models.TagApproved}
+		mockRepo.On("GetByID", ctx, uint(1)).Return(&models.Game{ID: 1}, nil)
+		mockRepo.On("GetTagByName", ctx, "roguelike").Return(tag, nil)
+		mockRepo.On("VoteGameTag", ctx, uint(1), uint(3), mock.MatchedBy(func(voter string) bool {
+			return len(voter) == 64 && voter != "ip:203.0.113.7"
+		})).Return(&models.GameTag{GameID: 1, TagID: 3, Votes: 5}, nil)
+
+		gameTag, err := service.VoteGameTag(ctx, 1, "Roguelike", "ip:203.0.113.7")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 5, gameTag.Votes)
+		assert.Equal(t, tag, gameTag.Tag)
+		mockRepo.AssertNotCalled(t, "CreateTag")
+	})
+
+	t.Run("succès vote tag - même votant, même empreinte", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		var voters []string
+		mockRepo.On("GetByID", ctx, uint(1)).Return(&models.Game{ID: 1}, nil)
+		mockRepo.On("GetTagByName", ctx, "roguelike").Return(&models.Tag{ID: 3, Name: "roguelike", Status: models.TagApproved}, nil)
+		mockRepo.On("VoteGameTag", ctx, uint(1), uint(3), mock.Anything).Run(func(args mock.Arguments) {
+			voters = append(voters, args.String(3))
+		}).Return(&models.GameTag{GameID: 1, TagID: 3, Votes: 1}, nil)
+
+		_, err := service.VoteGameTag(ctx, 1, "roguelike", "id:player-42")
+		assert.NoError(t, err)
+		_, err = service.VoteGameTag(ctx, 1, "roguelike", " id:player-42 ")
+		assert.NoError(t, err)
+		_, err = service.VoteGameTag(ctx, 1, "roguelike", "id:player-43")
+		assert.NoError(t, err)
+
+		assert.Len(t, voters, 3)
+		assert.Equal(t, voters[0], voters[1])
+		assert.NotEqual(t, voters[0], voters[2])
+	})
+
+	t.Run("échec vote tag - votant absent", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+
+		_, err := service.VoteGameTag(ctx, 1, "roguelike", "  ")
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		assert.Equal(t, "voter_id", apperrors.FieldsOf(err)[0].Field)
+		mockRepo.AssertNotCalled(t, "VoteGameTag")
+	})
+
+	t.Run("succès retrait vote tag - vote du votant uniquement", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		var voted, removed string
+		mockRepo.On("GetByID", ctx, uint(1)).Return(&models.Game{ID: 1}, nil)
+		mockRepo.On("GetTagByName", ctx, "roguelike").Return(&models.Tag{ID: 3, Name: "roguelike", Status: models.TagApproved}, nil)
+		mockRepo.On("VoteGameTag", ctx, uint(1), uint(3), mock.Anything).Run(func(args mock.Arguments) {
+			voted = args.String(3)
+		}).Return(&models.GameTag{GameID: 1, TagID: 3, Votes: 1}, nil)
+		mockRepo.On("UnvoteGameTag", ctx, uint(1), uint(3), mock.Anything).Run(func(args mock.Arguments) {
+			removed = args.String(3)
+		}).Return(nil)
+
+		_, err := service.VoteGameTag(ctx, 1, "roguelike", "ip:203.0.113.7")
+		assert.NoError(t, err)
+		err = service.RemoveGameTagVote(ctx, 1, 3, "ip:203.0.113.7")
+
+		assert.NoError(t, err)
+		assert.Equal(t, voted, removed)
+	})
+
+	t.Run("échec vote tag - tag refusé", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		mockRepo.On("GetByID", ctx, uint(1)).Return(&models.Game{ID: 1}, nil)
+		mockRepo.On("GetTagByName", ctx, "spam").Return(&models.Tag{ID: 4, Name: "spam", Status: models.TagRejected}, nil)
+
+		_, err := service.VoteGameTag(ctx, 1, "spam", "ip:203.0.113.7")
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		assert.Equal(t, "name", apperrors.FieldsOf(err)[0].Field)
+		mockRepo.AssertNotCalled(t, "VoteGameTag")
+	})
+
+	t.Run("échec liste tags - statut inconnu", func(t *testing.T) {
+		mockRepo, service := setupTest()
+
+		_, err := service.GetTags(context.Background(), "deleted")
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		mockRepo.AssertNotCalled(t, "GetTags")
+	})
+}
+
+func TestGameAttributes(t *testing.T) {
+	t.Run("succès création jeu - langues et modes normalisés", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		game := &models.Game{
+			Title:       "Game",
+			ReleaseDate: time.Date(2020, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Languages: []models.GameLanguage{
+				{Language: "fr-fr", Interface: true, Subtitles: true},
+				{Language: "EN", Interface: true, Audio: true},
+			},
+			GameModes:     []string{"Single_Player", "local_coop", "local_coop"},
+			MinPlayers:    1,
+			MaxPlayers:    4,
+			Accessibility: []string{" colorblind_mode "},
+		}
+		mockRepo.On("FindSlugs", ctx, mock.Anything).Return([]models.GameSlug{}, nil)
+		mockRepo.On("Create", ctx, game).Return(nil)
+
+		err := service.CreateGame(ctx, game)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "fr-FR", game.Languages[0].Language)
+		assert.Equal(t, "en", game.Languages[1].Language)
+		assert.Equal(t, []string{models.ModeSinglePlayer, models.ModeLocalCoop}, game.GameModes)
+		assert.Equal(t, []string{models.AccessibilityColorblindMode}, game.Accessibility)
+	})
+
+	t.Run("échec création jeu - attributs invalides", func(t *testing.T) {
+		mockRepo, service := setupTest()
+
+		err := service.CreateGame(context.Background(), &models.Game{
+			Title:       "Game",
+			ReleaseDate: time.Date(2020, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Languages: []models.GameLanguage{
+				{Language: "fr", Interface: true},
+				{Language: "FR", Audio: true},
+				{Language: "de"},
+				{Language: "not a language", Interface: true},
+			},
+			GameModes:  []string{"battle_royale"},
+			MinPlayers: 4,
+			MaxPlayers: 2,
+		})
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		fields := make([]string, 0)
+		for _, field := range apperrors.FieldsOf(err) {
+			fields = append(fields, field.Field)
+		}
+		assert.ElementsMatch(t, []string{"languages[1].language", "languages[2]", "languages[3].language", "game_modes[0]", "max_players"}, fields)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("succès liste jeux - langues du filtre normalisées", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		filter := &models.GameFilter{SubtitleLanguages: []string{"FR"}, GameModes: []string{"Local_Coop"}}
+		mockRepo.On("List", ctx, mock.MatchedBy(func(f *models.GameFilter) bool {
+			return f.SubtitleLanguages[0] == "fr" && f.GameModes[0] == models.ModeLocalCoop
+		})).Return(&models.GameResponse{}, nil)
+
+		_, err := service.ListGames(ctx, filter)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("échec liste jeux - langue invalide", func(t *testing.T) {
+		mockRepo, service := setupTest()
+
+		_, err := service.ListGames(context.Background(), &models.GameFilter{AudioLanguages: []string{"fr", "??"}})
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		assert.Equal(t, "audio_languages[1]", apperrors.FieldsOf(err)[0].Field)
+		mockRepo.AssertNotCalled(t, "List")
+	})
+}
+
+func TestExternalIDs(t *testing.T) {
+	t.Run("succès création jeu - identifiants normalisés", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		game := &models.Game{
+			Title:       "Game",
+			ReleaseDate: time.Date(2020, time.May, 1, 0, 0, 0, 0, time.UTC),
+			ExternalIDs: []models.GameExternalID{
+				{Provider: " Steam ", ExternalID: " 620 "},
+				{Provider: "IGDB", ExternalID: "72"},
+			},
+		}
+		mockRepo.On("FindExternalIDs", ctx, game.ExternalIDs).Return([]models.GameExternalID{}, nil)
+		mockRepo.On("FindSlugs", ctx, mock.Anything).Return([]models.GameSlug{}, nil)
+		mockRepo.On("Create", ctx, game).Return(nil)
+
+		err := service.CreateGame(ctx, game)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.GameExternalID{Provider: models.ProviderSteam, ExternalID: "620"}, game.ExternalIDs[0])
+		assert.Equal(t, models.ProviderIGDB, game.ExternalIDs[1].Provider)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("échec création jeu - identifiant dupliqué ou déjà associé", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		mockRepo.On("FindExternalIDs", ctx, mock.Anything).Return([]models.GameExternalID{
+			{GameID: 7, Provider: models.ProviderPSN, ExternalID: "CUSA00001"},
+		}, nil)
+
+		err := service.CreateGame(ctx, &models.Game{
+			Title:       "Game",
+			ReleaseDate: time.Date(2020, time.May, 1, 0, 0, 0, 0, time.UTC),
+			ExternalIDs: []models.GameExternalID{
+				{Provider: "steam", ExternalID: "620"},
+				{Provider: "steam", ExternalID: "620"},
+				{Provider: "psn", ExternalID: "CUSA00001"},
+				{Provider: "origin", ExternalID: "1"},
+			},
+		})
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		fields := make([]string, 0)
+		for _, field := range apperrors.FieldsOf(err) {
+			fields = append(fields, field.Field)
+		}
+		assert.ElementsMatch(t, []string{"external_ids[1].external_id", "external_ids[2].external_id", "external_ids[3].provider"}, fields)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("succès mise à jour jeu - identifiants déjà associés au jeu", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		game := &models.Game{
+			ID:          7,
+			Title:       "Game",
+			ReleaseDate: time.Date(2020, time.May, 1, 0, 0, 0, 0, time.UTC),
+			ExternalIDs: []models.GameExternalID{{Provider: "psn", ExternalID: "CUSA00001"}},
+		}
+		mockRepo.On("FindExternalIDs", ctx, game.ExternalIDs).Return([]models.GameExternalID{
+			{GameID: 7, Provider: models.ProviderPSN, ExternalID: "CUSA00001"},
+		}, nil)
+		mockRepo.On("GetByID", ctx, uint(7)).Return(&models.Game{ID: 7}, nil)
+		mockRepo.On("FindSlugs", ctx, mock.Anything).Return([]models.GameSlug{}, nil)
+		mockRepo.On("Update", ctx, game).Return(nil)
+
+		err := service.UpdateGame(ctx, game)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("succès recherche par identifiant externe", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		mockRepo.On("GetGameByExternalID", ctx, models.ProviderEShop, "70010000000025").Return(&models.Game{ID: 3}, nil)
+
+		game, err := service.GetGameByExternalID(ctx, "eShop", "70010000000025")
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(3), game.ID)
+	})
+
+	t.Run("échec recherche par identifiant externe - fournisseur inconnu", func(t *testing.T) {
+		mockRepo, service := setupTest()
+
+		_, err := service.GetGameByExternalID(context.Background(), "origin", "1")
+
+		assert.ErrorIs(t, err, apperrors.ErrValidation)
+		assert.Equal(t, "provider", apperrors.FieldsOf(err)[0].Field)
+		mockRepo.AssertNotCalled(t, "GetGameByExternalID")
+	})
+
+	t.Run("succès liste jeux - fournisseur manquant normalisé", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		mockRepo.On("List", ctx, mock.MatchedBy(func(f *models.GameFilter) bool {
+			return f.MissingExternalID == models.ProviderSteam
+		})).Return(&models.GameResponse{}, nil)
+
+		_, err := service.ListGames(ctx, &models.GameFilter{MissingExternalID: "Steam"})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestGameSlugs(t *testing.T) {
+	t.Run("succès création jeu - slug dérivé du titre et dédoublonné", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		game := &models.Game{
+			Title:       "Pokémon Rouge & Bleu",
+			ReleaseDate: time.Date(1996, time.February, 27, 0, 0, 0, 0, time.UTC),
+			UUID:        "00000000-0000-0000-0000-000000000001",
+		}
+		mockRepo.On("FindSlugs", ctx, "pokemon-rouge-bleu").Return([]models.GameSlug{
+			{GameID: 4, Slug: "pokemon-rouge-bleu"},
+			{GameID: 9, Slug: "pokemon-rouge-bleu-2"},
+		}, nil)
+		mockRepo.On("Create", ctx, game).Return(nil)
+
+		err := service.CreateGame(ctx, game)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "pokemon-rouge-bleu-3", game.Slug)
+		assert.Empty(t, game.UUID)
+	})
+
+	t.Run("succès création jeu - titre numérique", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		game := &models.Game{Title: "1942", ReleaseDate: time.Date(1984, time.December, 1, 0, 0, 0, 0, time.UTC)}
+		mockRepo.On("FindSlugs", ctx, "game-1942").Return([]models.GameSlug{}, nil)
+		mockRepo.On("Create", ctx, game).Return(nil)
+
+		err := service.CreateGame(ctx, game)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "game-1942", game.Slug)
+	})
+
+	t.Run("succès mise à jour jeu - nouveau titre, UUID conservé et ancien slug repris", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		game := &models.Game{ID: 7, Title: "Final Fantasy VII", ReleaseDate: time.Date(1997, time.January, 31, 0, 0, 0, 0, time.UTC)}
+		existing := &models.Game{ID: 7, Title: "FF7", UUID: "5f0c2a4e-8a43-4c5e-9a7b-3d2f1e0c9b8a", Slug: "ff7"}
+		mockRepo.On("GetByID", ctx, uint(7)).Return(existing, nil)
+		mockRepo.On("FindSlugs", ctx, "final-fantasy-vii").Return([]models.GameSlug{{GameID: 7, Slug: "final-fantasy-vii"}}, nil)
+		mockRepo.On("Update", ctx, mock.MatchedBy(func(g *models.Game) bool {
+			return g.UUID == existing.UUID && g.Slug == "final-fantasy-vii"
+		})).Return(nil)
+
+		err := service.UpdateGame(ctx, game)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("succès mise à jour jeu - titre inchangé, slug conservé", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		game := &models.Game{ID: 7, Title: "FF7", ReleaseDate: time.Date(1997, time.January, 31, 0, 0, 0, 0, time.UTC), Slug: "autre"}
+		mockRepo.On("GetByID", ctx, uint(7)).Return(&models.Game{ID: 7, Title: "FF7", Slug: "ff7-2"}, nil)
+		mockRepo.On("Update", ctx, mock.MatchedBy(func(g *models.Game) bool {
+			return g.Slug == "ff7-2"
+		})).Return(nil)
+
+		err := service.UpdateGame(ctx, game)
+
+		assert.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "FindSlugs")
+	})
+
+	t.Run("succès récupération jeu - par identifiant, UUID ou slug", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		game := &models.Game{ID: 7, Slug: "ff7"}
+		mockRepo.On("GetByID", ctx, uint(7)).Return(game, nil)
+		mockRepo.On("GetGameByUUID", ctx, "5f0c2a4e-8a43-4c5e-9a7b-3d2f1e0c9b8a").Return(game, nil)
+		mockRepo.On("GetGameBySlug", ctx, "ff7").Return(game, nil)
+
+		for _, ref := range []string{"7", "5F0C2A4E-8A43-4C5E-9A7B-3D2F1E0C9B8A", "FF7"} {
+			found, moved, err := service.GetGameByRef(ctx, ref)
+
+			assert.NoError(t, err)
+			assert.False(t, moved)
+			assert.Equal(t, uint(7), found.ID)
+		}
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("succès récupération jeu - ancien slug", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		mockRepo.On("GetGameBySlug", ctx, "ff7").Return(nil, apperrors.NotFound("game not found"))
+		mockRepo.On("FindSlugRedirect", ctx, "ff7").Return(&models.GameSlug{GameID: 7, Slug: "ff7"}, nil)
+		mockRepo.On("GetByID", ctx, uint(7)).Return(&models.Game{ID: 7, Slug: "final-fantasy-vii"}, nil)
+
+		game, moved, err := service.GetGameByRef(ctx, "ff7")
+
+		assert.NoError(t, err)
+		assert.True(t, moved)
+		assert.Equal(t, "final-fantasy-vii", game.Slug)
+	})
+
+	t.Run("succès résolution référence - identifiant, UUID, slug ou ancien slug", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		mockRepo.On("FindGameIDByUUID", ctx, "5f0c2a4e-8a43-4c5e-9a7b-3d2f1e0c9b8a").Return(uint(7), nil)
+		mockRepo.On("FindGameIDBySlug", ctx, "final-fantasy-vii").Return(uint(7), nil)
+		mockRepo.On("FindGameIDBySlug", ctx, "ff7").Return(uint(0), apperrors.NotFound("game not found"))
+		mockRepo.On("FindSlugRedirect", ctx, "ff7").Return(&models.GameSlug{GameID: 7, Slug: "ff7"}, nil)
+
+		for _, ref := range []string{"7", "5F0C2A4E-8A43-4C5E-9A7B-3D2F1E0C9B8A", "Final-Fantasy-VII", "ff7"} {
+			id, err := service.ResolveGameRef(ctx, ref)
+
+			assert.NoError(t, err)
+			assert.Equal(t, uint(7), id, ref)
+		}
+		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "GetByID")
+	})
+
+	t.Run("échec résolution référence - slug inconnu", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		mockRepo.On("FindGameIDBySlug", ctx, "inconnu").Return(uint(0), apperrors.NotFound("game not found"))
+		mockRepo.On("FindSlugRedirect", ctx, "inconnu").Return(nil, apperrors.NotFound("game not found"))
+
+		_, err := service.ResolveGameRef(ctx, "inconnu")
+
+		assert.ErrorIs(t, err, apperrors.ErrNotFound)
+	})
+
+	t.Run("échec récupération jeu - slug inconnu", func(t *testing.T) {
+		mockRepo, service := setupTest()
+		ctx := context.Background()
+		mockRepo.On("GetGameBySlug", ctx, "inconnu").Return(nil, apperrors.NotFound("game not found"))
+		mockRepo.On("FindSlugRedirect", ctx, "inconnu").Return(nil, apperrors.NotFound("game not found"))
+
+		_, _, err := service.GetGameByRef(ctx, "inconnu")
+
+		assert.ErrorIs(t, err, apperrors.ErrNotFound)
+		mockRepo.AssertNotCalled(t, "GetByID")
+	})
+}
+
 // func TestGetGameByID(t *testing.T) {
 // 	t.Run("succès récupération jeu", func(t *testing.T) {
 // 		// Arrange